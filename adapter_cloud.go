@@ -0,0 +1,360 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// NewCloudClient returns a Client that talks to Jira Cloud via c.
+func NewCloudClient(c *cloud.Client) Client {
+	return &cloudClient{client: c}
+}
+
+type cloudClient struct {
+	client *cloud.Client
+}
+
+func (c *cloudClient) Deployment() Deployment { return DeploymentCloud }
+func (c *cloudClient) Issue() IssueAPI        { return (*cloudIssueAPI)(c) }
+func (c *cloudClient) Project() ProjectAPI    { return (*cloudProjectAPI)(c) }
+func (c *cloudClient) Search() SearchAPI      { return (*cloudSearchAPI)(c) }
+func (c *cloudClient) User() UserAPI          { return (*cloudUserAPI)(c) }
+func (c *cloudClient) Version() VersionAPI    { return (*cloudVersionAPI)(c) }
+func (c *cloudClient) Worklog() WorklogAPI    { return (*cloudWorklogAPI)(c) }
+
+type cloudIssueAPI cloudClient
+
+func (a *cloudIssueAPI) Get(ctx context.Context, issueIDOrKey string) (*Issue, error) {
+	issue, _, err := a.client.Issue.Get(ctx, issueIDOrKey, nil)
+	if err != nil {
+		return nil, err
+	}
+	i := fromCloudIssue(issue)
+	return &i, nil
+}
+
+func (a *cloudIssueAPI) Create(ctx context.Context, issue *Issue) (*Issue, error) {
+	created, _, err := a.client.Issue.Create(ctx, toCloudIssue(issue))
+	if err != nil {
+		return nil, err
+	}
+	return &Issue{ID: created.ID, Key: created.Key, Self: created.Self}, nil
+}
+
+func (a *cloudIssueAPI) Update(ctx context.Context, issueIDOrKey string, fields map[string]interface{}) error {
+	_, err := a.client.Issue.UpdateIssue(ctx, issueIDOrKey, map[string]interface{}{"fields": fields})
+	return err
+}
+
+func (a *cloudIssueAPI) Assign(ctx context.Context, issueIDOrKey string, user *User) error {
+	if user == nil {
+		return a.Update(ctx, issueIDOrKey, map[string]interface{}{"assignee": nil})
+	}
+	_, err := a.client.Issue.UpdateAssignee(ctx, issueIDOrKey, toCloudUser(user))
+	return err
+}
+
+func (a *cloudIssueAPI) AddComment(ctx context.Context, issueIDOrKey string, body string) (*Comment, error) {
+	comment, _, err := a.client.Issue.AddComment(ctx, issueIDOrKey, &cloud.Comment{Body: body})
+	if err != nil {
+		return nil, err
+	}
+	return &Comment{
+		ID:      comment.ID,
+		Body:    comment.Body,
+		Author:  fromCloudUser(comment.Author),
+		Created: comment.Created,
+	}, nil
+}
+
+func (a *cloudIssueAPI) GetTransitions(ctx context.Context, issueIDOrKey string) ([]Transition, error) {
+	transitions, _, err := a.client.Issue.GetTransitions(ctx, issueIDOrKey)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]Transition, 0, len(transitions))
+	for _, t := range transitions {
+		result = append(result, Transition{ID: t.ID, Name: t.Name, ToStatus: t.To.Name})
+	}
+	return result, nil
+}
+
+func (a *cloudIssueAPI) DoTransition(ctx context.Context, issueIDOrKey string, transitionID string) error {
+	_, err := a.client.Issue.DoTransition(ctx, issueIDOrKey, transitionID)
+	return err
+}
+
+type cloudProjectAPI cloudClient
+
+func (a *cloudProjectAPI) Get(ctx context.Context, projectIDOrKey string) (*Project, error) {
+	project, _, err := a.client.Project.Get(ctx, projectIDOrKey)
+	if err != nil {
+		return nil, err
+	}
+	p := fromCloudProject(project)
+	return &p, nil
+}
+
+func (a *cloudProjectAPI) GetAll(ctx context.Context) ([]Project, error) {
+	projects, _, err := a.client.Project.GetAll(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]Project, 0, len(*projects))
+	for _, p := range *projects {
+		result = append(result, Project{ID: p.ID, Key: p.Key, Name: p.Name})
+	}
+	return result, nil
+}
+
+type cloudVersionAPI cloudClient
+
+func (a *cloudVersionAPI) Get(ctx context.Context, versionID string) (*Version, error) {
+	id, err := strconv.Atoi(versionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version ID %q: %w", versionID, err)
+	}
+	version, _, err := a.client.Version.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	v := fromCloudVersion(version)
+	return &v, nil
+}
+
+func (a *cloudVersionAPI) ListByProject(ctx context.Context, projectIDOrKey string) ([]Version, error) {
+	var result []Version
+	options := &cloud.VersionListOptions{}
+	for {
+		versions, resp, err := a.client.Version.ListByProject(ctx, projectIDOrKey, options)
+		if err != nil {
+			return nil, err
+		}
+		for i := range versions {
+			result = append(result, fromCloudVersion(&versions[i]))
+		}
+		if resp.IsLast || len(versions) == 0 {
+			return result, nil
+		}
+		options.StartAt += len(versions)
+	}
+}
+
+type cloudWorklogAPI cloudClient
+
+func (a *cloudWorklogAPI) ListByIssue(ctx context.Context, issueIDOrKey string) ([]Worklog, error) {
+	var result []Worklog
+	options := &cloud.GetWorklogsQueryOptions{}
+	for {
+		worklog, _, err := a.client.Issue.GetWorklogs(ctx, issueIDOrKey, cloud.WithQueryOptions(options))
+		if err != nil {
+			return nil, err
+		}
+		for i := range worklog.Worklogs {
+			result = append(result, fromCloudWorklog(&worklog.Worklogs[i]))
+		}
+		options.StartAt += int64(len(worklog.Worklogs))
+		if len(worklog.Worklogs) == 0 || options.StartAt >= int64(worklog.Total) {
+			return result, nil
+		}
+	}
+}
+
+type cloudSearchAPI cloudClient
+
+func (a *cloudSearchAPI) Search(ctx context.Context, jql string, options *SearchOptions) (*SearchResult, error) {
+	if options == nil {
+		options = &SearchOptions{}
+	}
+	fields := options.Fields
+	if len(fields) == 0 {
+		// Jira Cloud only returns the issue IDs by default
+		fields = []string{"*navigable"}
+	}
+
+	issues, resp, err := a.client.Issue.SearchV2JQL(ctx, jql, &cloud.SearchOptionsV2{
+		NextPageToken: options.PageToken,
+		MaxResults:    options.MaxResults,
+		Fields:        fields,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SearchResult{Issues: make([]Issue, 0, len(issues))}
+	for i := range issues {
+		result.Issues = append(result.Issues, fromCloudIssue(&issues[i]))
+	}
+	if !resp.IsLast {
+		result.NextPageToken = resp.NextPageToken
+	}
+	return result, nil
+}
+
+func (a *cloudSearchAPI) SearchPages(ctx context.Context, jql string, options *SearchOptions, f func(Issue) error) error {
+	return searchPages(ctx, a, jql, options, f)
+}
+
+type cloudUserAPI cloudClient
+
+func (a *cloudUserAPI) GetCurrentUser(ctx context.Context) (*User, error) {
+	user, _, err := a.client.User.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return fromCloudUser(user), nil
+}
+
+func (a *cloudUserAPI) Get(ctx context.Context, userID string) (*User, error) {
+	user, _, err := a.client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return fromCloudUser(user), nil
+}
+
+func (a *cloudUserAPI) Find(ctx context.Context, query string) ([]User, error) {
+	users, _, err := a.client.User.Find(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]User, 0, len(users))
+	for i := range users {
+		result = append(result, *fromCloudUser(&users[i]))
+	}
+	return result, nil
+}
+
+func fromCloudUser(u *cloud.User) *User {
+	if u == nil {
+		return nil
+	}
+	return &User{
+		ID:           u.AccountID,
+		DisplayName:  u.DisplayName,
+		EmailAddress: u.EmailAddress,
+		Active:       u.Active,
+		TimeZone:     u.TimeZone,
+	}
+}
+
+func toCloudUser(u *User) *cloud.User {
+	if u == nil {
+		return nil
+	}
+	return &cloud.User{AccountID: u.ID}
+}
+
+func fromCloudProject(p *cloud.Project) Project {
+	project := Project{
+		ID:          p.ID,
+		Key:         p.Key,
+		Name:        p.Name,
+		Description: p.Description,
+	}
+	if p.Lead.AccountID != "" {
+		project.Lead = fromCloudUser(&p.Lead)
+	}
+	return project
+}
+
+func fromCloudVersion(v *cloud.Version) Version {
+	return Version{
+		ID:          v.ID,
+		Self:        v.Self,
+		Name:        v.Name,
+		Description: v.Description,
+		Released:    v.Released != nil && *v.Released,
+		Archived:    v.Archived != nil && *v.Archived,
+		ReleaseDate: v.ReleaseDate,
+	}
+}
+
+func fromCloudWorklog(w *cloud.WorklogRecord) Worklog {
+	worklog := Worklog{
+		ID:        w.ID,
+		IssueID:   w.IssueID,
+		Author:    fromCloudUser(w.Author),
+		Comment:   w.Comment,
+		TimeSpent: time.Duration(w.TimeSpentSeconds) * time.Second,
+	}
+	if w.Started != nil {
+		worklog.Started = time.Time(*w.Started)
+	}
+	return worklog
+}
+
+func fromCloudIssue(i *cloud.Issue) Issue {
+	issue := Issue{ID: i.ID, Key: i.Key, Self: i.Self}
+	f := i.Fields
+	if f == nil {
+		return issue
+	}
+
+	issue.Project = fromCloudProject(&f.Project)
+	issue.Type = f.Type.Name
+	issue.Summary = f.Summary
+	issue.Description = f.Description
+	issue.Assignee = fromCloudUser(f.Assignee)
+	issue.Reporter = fromCloudUser(f.Reporter)
+	issue.Labels = f.Labels
+	issue.Created = time.Time(f.Created)
+	issue.Updated = time.Time(f.Updated)
+	if f.Status != nil {
+		issue.Status = f.Status.Name
+	}
+	if f.Priority != nil {
+		issue.Priority = f.Priority.Name
+	}
+	if f.Resolution != nil {
+		issue.Resolution = f.Resolution.Name
+	}
+	for _, c := range f.Components {
+		issue.Components = append(issue.Components, c.Name)
+	}
+	for _, v := range f.FixVersions {
+		issue.FixVersions = append(issue.FixVersions, Version{
+			ID:          v.ID,
+			Self:        v.Self,
+			Name:        v.Name,
+			Description: v.Description,
+			Released:    v.Released != nil && *v.Released,
+			Archived:    v.Archived != nil && *v.Archived,
+			ReleaseDate: v.ReleaseDate,
+		})
+	}
+	if len(f.Unknowns) > 0 {
+		issue.CustomFields = make(map[string]interface{}, len(f.Unknowns))
+		for k, v := range f.Unknowns {
+			issue.CustomFields[k] = v
+		}
+	}
+	return issue
+}
+
+func toCloudIssue(i *Issue) *cloud.Issue {
+	f := &cloud.IssueFields{
+		Project:     cloud.Project{ID: i.Project.ID, Key: i.Project.Key},
+		Type:        cloud.IssueType{Name: i.Type},
+		Summary:     i.Summary,
+		Description: i.Description,
+		Assignee:    toCloudUser(i.Assignee),
+		Reporter:    toCloudUser(i.Reporter),
+		Labels:      i.Labels,
+		Unknowns:    i.CustomFields,
+	}
+	if i.Priority != "" {
+		f.Priority = &cloud.Priority{Name: i.Priority}
+	}
+	for _, name := range i.Components {
+		f.Components = append(f.Components, &cloud.Component{Name: name})
+	}
+	for _, v := range i.FixVersions {
+		f.FixVersions = append(f.FixVersions, &cloud.FixVersion{ID: v.ID, Name: v.Name})
+	}
+	return &cloud.Issue{Fields: f}
+}
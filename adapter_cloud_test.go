@@ -0,0 +1,206 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+func newTestCloudClient(t *testing.T) Client {
+	c, err := cloud.NewClient(testServer.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewCloudClient(c)
+}
+
+func TestCloudClient_Deployment(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if got := newTestCloudClient(t).Deployment(); got != DeploymentCloud {
+		t.Errorf("Deployment = %v, want %v", got, DeploymentCloud)
+	}
+}
+
+func TestCloudIssueAPI_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1","fields":{
+			"summary":"Broken build","issuetype":{"name":"Bug"},"project":{"id":"10000","key":"EX"},
+			"status":{"name":"Open"},"assignee":{"accountId":"5b10a2844c20165700ede21g","displayName":"Mia"},
+			"components":[{"name":"API"}],"fixVersions":[{"id":"1","name":"1.0","released":true}],
+			"customfield_10010":"foo"}}`)
+	})
+
+	issue, err := newTestCloudClient(t).Issue().Get(context.Background(), "EX-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Key != "EX-1" || issue.Summary != "Broken build" || issue.Type != "Bug" || issue.Status != "Open" {
+		t.Errorf("Unexpected issue: %+v", issue)
+	}
+	if issue.Project.Key != "EX" {
+		t.Errorf("Project.Key = %q, want EX", issue.Project.Key)
+	}
+	if issue.Assignee == nil || issue.Assignee.ID != "5b10a2844c20165700ede21g" {
+		t.Errorf("Expected assignee to be identified by the accountId, got %+v", issue.Assignee)
+	}
+	if len(issue.Components) != 1 || issue.Components[0] != "API" {
+		t.Errorf("Unexpected components: %v", issue.Components)
+	}
+	if len(issue.FixVersions) != 1 || !issue.FixVersions[0].Released {
+		t.Errorf("Unexpected fix versions: %+v", issue.FixVersions)
+	}
+	if issue.CustomFields["customfield_10010"] != "foo" {
+		t.Errorf("Unexpected custom fields: %v", issue.CustomFields)
+	}
+}
+
+func TestCloudIssueAPI_Assign(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/assignee", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["accountId"] != "5b10a2844c20165700ede21g" {
+			t.Errorf("Expected assignee to be sent as accountId, got %v", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := newTestCloudClient(t).Issue().Assign(context.Background(), "EX-1", &User{ID: "5b10a2844c20165700ede21g"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestCloudSearchAPI_SearchPages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/search/jql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"jql": "project = EX", "fields": "*navigable"})
+		switch r.URL.Query().Get("nextPageToken") {
+		case "":
+			fmt.Fprint(w, `{"issues":[{"key":"EX-1"},{"key":"EX-2"}],"nextPageToken":"abc","isLast":false}`)
+		case "abc":
+			fmt.Fprint(w, `{"issues":[{"key":"EX-3"}],"isLast":true}`)
+		default:
+			t.Errorf("Unexpected page token %q", r.URL.Query().Get("nextPageToken"))
+		}
+	})
+
+	var keys []string
+	err := newTestCloudClient(t).Search().SearchPages(context.Background(), "project = EX", nil, func(i Issue) error {
+		keys = append(keys, i.Key)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if fmt.Sprint(keys) != "[EX-1 EX-2 EX-3]" {
+		t.Errorf("Unexpected issues: %v", keys)
+	}
+}
+
+func TestCloudVersionAPI_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/version/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/version/10000","id":"10000","name":"1.0","released":true,"releaseDate":"2026-10-01"}`)
+	})
+
+	version, err := newTestCloudClient(t).Version().Get(context.Background(), "10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if version.ID != "10000" || version.Name != "1.0" || !version.Released || version.Archived || version.ReleaseDate != "2026-10-01" {
+		t.Errorf("Unexpected version: %+v", version)
+	}
+
+	if _, err := newTestCloudClient(t).Version().Get(context.Background(), "abc"); err == nil {
+		t.Error("Expected an error for an invalid version ID")
+	}
+}
+
+func TestCloudVersionAPI_ListByProject(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/project/EX/version", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("startAt") == "" {
+			fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"isLast":false,"values":[{"id":"10000","name":"1.0"}]}`)
+			return
+		}
+		testRequestParams(t, r, map[string]string{"startAt": "1"})
+		fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"isLast":true,"values":[{"id":"10001","name":"2.0","archived":true}]}`)
+	})
+
+	versions, err := newTestCloudClient(t).Version().ListByProject(context.Background(), "EX")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(versions) != 2 || versions[0].Name != "1.0" || versions[1].Name != "2.0" || !versions[1].Archived {
+		t.Errorf("Unexpected versions: %+v", versions)
+	}
+}
+
+func TestCloudUserAPI_GetCurrentUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/3/myself", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"accountId":"5b10a2844c20165700ede21g","displayName":"Mia","active":true}`)
+	})
+
+	user, err := newTestCloudClient(t).User().GetCurrentUser(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if user.ID != "5b10a2844c20165700ede21g" || user.DisplayName != "Mia" || !user.Active {
+		t.Errorf("Unexpected user: %+v", user)
+	}
+}
+
+func TestCloudWorklogAPI_ListByIssue(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/worklog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("startAt") == "" {
+			fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"worklogs":[{"id":"100","issueId":"10000","author":{"accountId":"5b10a2844c20165700ede21g","displayName":"Mia"},"started":"2026-10-01T09:00:00.000+0200","timeSpentSeconds":5400}]}`)
+			return
+		}
+		testRequestParams(t, r, map[string]string{"startAt": "1"})
+		fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"worklogs":[{"id":"101","issueId":"10000","timeSpentSeconds":60}]}`)
+	})
+
+	worklogs, err := newTestCloudClient(t).Worklog().ListByIssue(context.Background(), "EX-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(worklogs) != 2 || worklogs[1].ID != "101" {
+		t.Fatalf("Unexpected worklogs: %+v", worklogs)
+	}
+	w := worklogs[0]
+	if w.Author == nil || w.Author.ID != "5b10a2844c20165700ede21g" || w.TimeSpent != 90*time.Minute || !w.Started.Equal(time.Date(2026, 10, 1, 7, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected worklog: %+v", w)
+	}
+}
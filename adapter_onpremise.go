@@ -0,0 +1,375 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/andygrunwald/go-jira/v2/onpremise"
+)
+
+// NewOnPremiseClient returns a Client that talks to Jira Server / Data Center via c.
+func NewOnPremiseClient(c *onpremise.Client) Client {
+	return &onPremiseClient{client: c}
+}
+
+type onPremiseClient struct {
+	client *onpremise.Client
+}
+
+func (c *onPremiseClient) Deployment() Deployment { return DeploymentOnPremise }
+func (c *onPremiseClient) Issue() IssueAPI        { return (*onPremiseIssueAPI)(c) }
+func (c *onPremiseClient) Project() ProjectAPI    { return (*onPremiseProjectAPI)(c) }
+func (c *onPremiseClient) Search() SearchAPI      { return (*onPremiseSearchAPI)(c) }
+func (c *onPremiseClient) User() UserAPI          { return (*onPremiseUserAPI)(c) }
+func (c *onPremiseClient) Version() VersionAPI    { return (*onPremiseVersionAPI)(c) }
+func (c *onPremiseClient) Worklog() WorklogAPI    { return (*onPremiseWorklogAPI)(c) }
+
+type onPremiseIssueAPI onPremiseClient
+
+func (a *onPremiseIssueAPI) Get(ctx context.Context, issueIDOrKey string) (*Issue, error) {
+	issue, _, err := a.client.Issue.Get(ctx, issueIDOrKey, nil)
+	if err != nil {
+		return nil, err
+	}
+	i := fromOnPremiseIssue(issue)
+	return &i, nil
+}
+
+func (a *onPremiseIssueAPI) Create(ctx context.Context, issue *Issue) (*Issue, error) {
+	created, _, err := a.client.Issue.Create(ctx, toOnPremiseIssue(issue))
+	if err != nil {
+		return nil, err
+	}
+	return &Issue{ID: created.ID, Key: created.Key, Self: created.Self}, nil
+}
+
+func (a *onPremiseIssueAPI) Update(ctx context.Context, issueIDOrKey string, fields map[string]interface{}) error {
+	_, err := a.client.Issue.UpdateIssue(ctx, issueIDOrKey, map[string]interface{}{"fields": fields})
+	return err
+}
+
+func (a *onPremiseIssueAPI) Assign(ctx context.Context, issueIDOrKey string, user *User) error {
+	if user == nil {
+		return a.Update(ctx, issueIDOrKey, map[string]interface{}{"assignee": nil})
+	}
+	_, err := a.client.Issue.UpdateAssignee(ctx, issueIDOrKey, toOnPremiseUser(user))
+	return err
+}
+
+func (a *onPremiseIssueAPI) AddComment(ctx context.Context, issueIDOrKey string, body string) (*Comment, error) {
+	comment, _, err := a.client.Issue.AddComment(ctx, issueIDOrKey, &onpremise.Comment{Body: body})
+	if err != nil {
+		return nil, err
+	}
+	return &Comment{
+		ID:      comment.ID,
+		Body:    comment.Body,
+		Author:  fromOnPremiseUser(comment.Author),
+		Created: comment.Created,
+	}, nil
+}
+
+func (a *onPremiseIssueAPI) GetTransitions(ctx context.Context, issueIDOrKey string) ([]Transition, error) {
+	transitions, _, err := a.client.Issue.GetTransitions(ctx, issueIDOrKey)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]Transition, 0, len(transitions))
+	for _, t := range transitions {
+		result = append(result, Transition{ID: t.ID, Name: t.Name, ToStatus: t.To.Name})
+	}
+	return result, nil
+}
+
+func (a *onPremiseIssueAPI) DoTransition(ctx context.Context, issueIDOrKey string, transitionID string) error {
+	_, err := a.client.Issue.DoTransition(ctx, issueIDOrKey, transitionID)
+	return err
+}
+
+type onPremiseProjectAPI onPremiseClient
+
+func (a *onPremiseProjectAPI) Get(ctx context.Context, projectIDOrKey string) (*Project, error) {
+	project, _, err := a.client.Project.Get(ctx, projectIDOrKey)
+	if err != nil {
+		return nil, err
+	}
+	p := fromOnPremiseProject(project)
+	return &p, nil
+}
+
+func (a *onPremiseProjectAPI) GetAll(ctx context.Context) ([]Project, error) {
+	projects, _, err := a.client.Project.GetAll(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]Project, 0, len(*projects))
+	for _, p := range *projects {
+		result = append(result, Project{ID: p.ID, Key: p.Key, Name: p.Name})
+	}
+	return result, nil
+}
+
+type onPremiseVersionAPI onPremiseClient
+
+func (a *onPremiseVersionAPI) Get(ctx context.Context, versionID string) (*Version, error) {
+	id, err := strconv.Atoi(versionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version ID %q: %w", versionID, err)
+	}
+	version, _, err := a.client.Version.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	v := fromOnPremiseVersion(version)
+	return &v, nil
+}
+
+func (a *onPremiseVersionAPI) ListByProject(ctx context.Context, projectIDOrKey string) ([]Version, error) {
+	var result []Version
+	options := &onpremise.VersionListOptions{}
+	for {
+		versions, resp, err := a.client.Version.ListByProject(ctx, projectIDOrKey, options)
+		if err != nil {
+			return nil, err
+		}
+		for i := range versions {
+			result = append(result, fromOnPremiseVersion(&versions[i]))
+		}
+		if resp.IsLast || len(versions) == 0 {
+			return result, nil
+		}
+		options.StartAt += len(versions)
+	}
+}
+
+type onPremiseWorklogAPI onPremiseClient
+
+func (a *onPremiseWorklogAPI) ListByIssue(ctx context.Context, issueIDOrKey string) ([]Worklog, error) {
+	var result []Worklog
+	options := &onpremise.GetWorklogsQueryOptions{}
+	for {
+		worklog, _, err := a.client.Issue.GetWorklogs(ctx, issueIDOrKey, onpremise.WithQueryOptions(options))
+		if err != nil {
+			return nil, err
+		}
+		for i := range worklog.Worklogs {
+			result = append(result, fromOnPremiseWorklog(&worklog.Worklogs[i]))
+		}
+		options.StartAt += int64(len(worklog.Worklogs))
+		if len(worklog.Worklogs) == 0 || options.StartAt >= int64(worklog.Total) {
+			return result, nil
+		}
+	}
+}
+
+type onPremiseSearchAPI onPremiseClient
+
+func (a *onPremiseSearchAPI) Search(ctx context.Context, jql string, options *SearchOptions) (*SearchResult, error) {
+	if options == nil {
+		options = &SearchOptions{}
+	}
+
+	// Jira Server / Data Center pages by offset, so the offset is our page token
+	startAt := 0
+	if options.PageToken != "" {
+		var err error
+		startAt, err = strconv.Atoi(options.PageToken)
+		if err != nil {
+			return nil, fmt.Errorf("invalid page token %q: %w", options.PageToken, err)
+		}
+	}
+
+	issues, resp, err := a.client.Issue.Search(ctx, jql, &onpremise.SearchOptions{
+		StartAt:    startAt,
+		MaxResults: options.MaxResults,
+		Fields:     options.Fields,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SearchResult{Issues: make([]Issue, 0, len(issues))}
+	for i := range issues {
+		result.Issues = append(result.Issues, fromOnPremiseIssue(&issues[i]))
+	}
+	if next := resp.StartAt + len(issues); len(issues) > 0 && next < resp.Total {
+		result.NextPageToken = strconv.Itoa(next)
+	}
+	return result, nil
+}
+
+func (a *onPremiseSearchAPI) SearchPages(ctx context.Context, jql string, options *SearchOptions, f func(Issue) error) error {
+	return searchPages(ctx, a, jql, options, f)
+}
+
+type onPremiseUserAPI onPremiseClient
+
+func (a *onPremiseUserAPI) GetCurrentUser(ctx context.Context) (*User, error) {
+	user, _, err := a.client.User.GetSelf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return fromOnPremiseUser(user), nil
+}
+
+func (a *onPremiseUserAPI) Get(ctx context.Context, userID string) (*User, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/user?username=%s", url.QueryEscape(userID))
+	req, err := a.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	user := new(onpremise.User)
+	resp, err := a.client.Do(req, user)
+	if err != nil {
+		return nil, onpremise.NewJiraError(resp, err)
+	}
+	return fromOnPremiseUser(user), nil
+}
+
+func (a *onPremiseUserAPI) Find(ctx context.Context, query string) ([]User, error) {
+	// Jira Server / Data Center matches the username parameter against name, display name and email address
+	users, _, err := a.client.User.Find(ctx, query, onpremise.WithUsername(url.QueryEscape(query)))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]User, 0, len(users))
+	for i := range users {
+		result = append(result, *fromOnPremiseUser(&users[i]))
+	}
+	return result, nil
+}
+
+func fromOnPremiseUser(u *onpremise.User) *User {
+	if u == nil {
+		return nil
+	}
+	return &User{
+		ID:           u.Name,
+		DisplayName:  u.DisplayName,
+		EmailAddress: u.EmailAddress,
+		Active:       u.Active,
+		TimeZone:     u.TimeZone,
+	}
+}
+
+func toOnPremiseUser(u *User) *onpremise.User {
+	if u == nil {
+		return nil
+	}
+	return &onpremise.User{Name: u.ID}
+}
+
+func fromOnPremiseProject(p *onpremise.Project) Project {
+	project := Project{
+		ID:          p.ID,
+		Key:         p.Key,
+		Name:        p.Name,
+		Description: p.Description,
+	}
+	if p.Lead.Name != "" {
+		project.Lead = fromOnPremiseUser(&p.Lead)
+	}
+	return project
+}
+
+func fromOnPremiseVersion(v *onpremise.Version) Version {
+	return Version{
+		ID:          v.ID,
+		Self:        v.Self,
+		Name:        v.Name,
+		Description: v.Description,
+		Released:    v.Released != nil && *v.Released,
+		Archived:    v.Archived != nil && *v.Archived,
+		ReleaseDate: v.ReleaseDate,
+	}
+}
+
+func fromOnPremiseWorklog(w *onpremise.WorklogRecord) Worklog {
+	worklog := Worklog{
+		ID:        w.ID,
+		IssueID:   w.IssueID,
+		Author:    fromOnPremiseUser(w.Author),
+		Comment:   w.Comment,
+		TimeSpent: time.Duration(w.TimeSpentSeconds) * time.Second,
+	}
+	if w.Started != nil {
+		worklog.Started = time.Time(*w.Started)
+	}
+	return worklog
+}
+
+func fromOnPremiseIssue(i *onpremise.Issue) Issue {
+	issue := Issue{ID: i.ID, Key: i.Key, Self: i.Self}
+	f := i.Fields
+	if f == nil {
+		return issue
+	}
+
+	issue.Project = fromOnPremiseProject(&f.Project)
+	issue.Type = f.Type.Name
+	issue.Summary = f.Summary
+	issue.Description = f.Description
+	issue.Assignee = fromOnPremiseUser(f.Assignee)
+	issue.Reporter = fromOnPremiseUser(f.Reporter)
+	issue.Labels = f.Labels
+	issue.Created = time.Time(f.Created)
+	issue.Updated = time.Time(f.Updated)
+	if f.Status != nil {
+		issue.Status = f.Status.Name
+	}
+	if f.Priority != nil {
+		issue.Priority = f.Priority.Name
+	}
+	if f.Resolution != nil {
+		issue.Resolution = f.Resolution.Name
+	}
+	for _, c := range f.Components {
+		issue.Components = append(issue.Components, c.Name)
+	}
+	for _, v := range f.FixVersions {
+		issue.FixVersions = append(issue.FixVersions, Version{
+			ID:          v.ID,
+			Self:        v.Self,
+			Name:        v.Name,
+			Description: v.Description,
+			Released:    v.Released != nil && *v.Released,
+			Archived:    v.Archived != nil && *v.Archived,
+			ReleaseDate: v.ReleaseDate,
+		})
+	}
+	if len(f.Unknowns) > 0 {
+		issue.CustomFields = make(map[string]interface{}, len(f.Unknowns))
+		for k, v := range f.Unknowns {
+			issue.CustomFields[k] = v
+		}
+	}
+	return issue
+}
+
+func toOnPremiseIssue(i *Issue) *onpremise.Issue {
+	f := &onpremise.IssueFields{
+		Project:     onpremise.Project{ID: i.Project.ID, Key: i.Project.Key},
+		Type:        onpremise.IssueType{Name: i.Type},
+		Summary:     i.Summary,
+		Description: i.Description,
+		Assignee:    toOnPremiseUser(i.Assignee),
+		Reporter:    toOnPremiseUser(i.Reporter),
+		Labels:      i.Labels,
+		Unknowns:    i.CustomFields,
+	}
+	if i.Priority != "" {
+		f.Priority = &onpremise.Priority{Name: i.Priority}
+	}
+	for _, name := range i.Components {
+		f.Components = append(f.Components, &onpremise.Component{Name: name})
+	}
+	for _, v := range i.FixVersions {
+		f.FixVersions = append(f.FixVersions, &onpremise.FixVersion{ID: v.ID, Name: v.Name})
+	}
+	return &onpremise.Issue{Fields: f}
+}
@@ -0,0 +1,220 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/andygrunwald/go-jira/v2/onpremise"
+)
+
+func newTestOnPremiseClient(t *testing.T) Client {
+	c, err := onpremise.NewClient(testServer.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewOnPremiseClient(c)
+}
+
+func TestOnPremiseClient_Deployment(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if got := newTestOnPremiseClient(t).Deployment(); got != DeploymentOnPremise {
+		t.Errorf("Deployment = %v, want %v", got, DeploymentOnPremise)
+	}
+}
+
+func TestOnPremiseIssueAPI_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1","fields":{
+			"summary":"Broken build","issuetype":{"name":"Bug"},"project":{"id":"10000","key":"EX"},
+			"status":{"name":"Open"},"assignee":{"name":"mia","displayName":"Mia"}}}`)
+	})
+
+	issue, err := newTestOnPremiseClient(t).Issue().Get(context.Background(), "EX-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Key != "EX-1" || issue.Summary != "Broken build" || issue.Type != "Bug" || issue.Status != "Open" {
+		t.Errorf("Unexpected issue: %+v", issue)
+	}
+	if issue.Assignee == nil || issue.Assignee.ID != "mia" {
+		t.Errorf("Expected assignee to be identified by the username, got %+v", issue.Assignee)
+	}
+}
+
+func TestOnPremiseIssueAPI_Assign(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/assignee", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["name"] != "mia" {
+			t.Errorf("Expected assignee to be sent as name, got %v", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := newTestOnPremiseClient(t).Issue().Assign(context.Background(), "EX-1", &User{ID: "mia"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestOnPremiseSearchAPI_SearchPages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":2,"total":3,"issues":[{"key":"EX-1"},{"key":"EX-2"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"startAt":2,"maxResults":2,"total":3,"issues":[{"key":"EX-3"}]}`)
+		default:
+			t.Errorf("Unexpected startAt %q", r.URL.Query().Get("startAt"))
+		}
+	})
+
+	var keys []string
+	err := newTestOnPremiseClient(t).Search().SearchPages(context.Background(), "project = EX", nil, func(i Issue) error {
+		keys = append(keys, i.Key)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if fmt.Sprint(keys) != "[EX-1 EX-2 EX-3]" {
+		t.Errorf("Unexpected issues: %v", keys)
+	}
+}
+
+func TestOnPremiseSearchAPI_Search_InvalidPageToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := newTestOnPremiseClient(t).Search().Search(context.Background(), "", &SearchOptions{PageToken: "abc"})
+	if err == nil {
+		t.Error("Expected an error for an invalid page token")
+	}
+}
+
+func TestOnPremiseVersionAPI_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/version/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/version/10000","id":"10000","name":"1.0","released":true,"releaseDate":"2026-10-01"}`)
+	})
+
+	version, err := newTestOnPremiseClient(t).Version().Get(context.Background(), "10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if version.ID != "10000" || version.Name != "1.0" || !version.Released || version.Archived || version.ReleaseDate != "2026-10-01" {
+		t.Errorf("Unexpected version: %+v", version)
+	}
+
+	if _, err := newTestOnPremiseClient(t).Version().Get(context.Background(), "abc"); err == nil {
+		t.Error("Expected an error for an invalid version ID")
+	}
+}
+
+func TestOnPremiseVersionAPI_ListByProject(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/project/EX/version", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("startAt") == "" {
+			fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"isLast":false,"values":[{"id":"10000","name":"1.0"}]}`)
+			return
+		}
+		testRequestParams(t, r, map[string]string{"startAt": "1"})
+		fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"isLast":true,"values":[{"id":"10001","name":"2.0","archived":true}]}`)
+	})
+
+	versions, err := newTestOnPremiseClient(t).Version().ListByProject(context.Background(), "EX")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(versions) != 2 || versions[0].Name != "1.0" || versions[1].Name != "2.0" || !versions[1].Archived {
+		t.Errorf("Unexpected versions: %+v", versions)
+	}
+}
+
+func TestOnPremiseUserAPI_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"username": "mia"})
+		fmt.Fprint(w, `{"name":"mia","displayName":"Mia","active":true}`)
+	})
+
+	user, err := newTestOnPremiseClient(t).User().Get(context.Background(), "mia")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if user.ID != "mia" || user.DisplayName != "Mia" {
+		t.Errorf("Unexpected user: %+v", user)
+	}
+}
+
+func TestOnPremiseUserAPI_GetCurrentUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"name":"mia","displayName":"Mia","active":true}`)
+	})
+
+	user, err := newTestOnPremiseClient(t).User().GetCurrentUser(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if user.ID != "mia" {
+		t.Errorf("Unexpected user: %+v", user)
+	}
+}
+
+func TestOnPremiseWorklogAPI_ListByIssue(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/worklog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("startAt") == "" {
+			fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"worklogs":[{"id":"100","issueId":"10000","author":{"name":"mia","displayName":"Mia"},"started":"2026-10-01T09:00:00.000+0200","timeSpentSeconds":5400}]}`)
+			return
+		}
+		testRequestParams(t, r, map[string]string{"startAt": "1"})
+		fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"worklogs":[{"id":"101","issueId":"10000","timeSpentSeconds":60}]}`)
+	})
+
+	worklogs, err := newTestOnPremiseClient(t).Worklog().ListByIssue(context.Background(), "EX-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(worklogs) != 2 || worklogs[1].ID != "101" {
+		t.Fatalf("Unexpected worklogs: %+v", worklogs)
+	}
+	w := worklogs[0]
+	if w.Author == nil || w.Author.ID != "mia" || w.TimeSpent != 90*time.Minute || !w.Started.Equal(time.Date(2026, 10, 1, 7, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected worklog: %+v", w)
+	}
+}
@@ -1,17 +1,13 @@
 package cloud
 
-import "net/http"
+import (
+	"net/http"
+
+	"github.com/andygrunwald/go-jira/v2/internal/core"
+)
 
 // cloneRequest returns a clone of the provided *http.Request.
 // The clone is a shallow copy of the struct and its Header map.
 func cloneRequest(r *http.Request) *http.Request {
-	// shallow copy of the struct
-	r2 := new(http.Request)
-	*r2 = *r
-	// deep copy of the Header
-	r2.Header = make(http.Header, len(r.Header))
-	for k, s := range r.Header {
-		r2.Header[k] = append([]string(nil), s...)
-	}
-	return r2
+	return core.CloneRequest(r)
 }
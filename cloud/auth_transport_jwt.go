@@ -1,15 +1,12 @@
 package cloud
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
 	"net/http"
 	"net/url"
-	"sort"
-	"strings"
 	"time"
 
+	"github.com/andygrunwald/go-jira/v2/internal/core"
 	jwt "github.com/golang-jwt/jwt/v4"
 )
 
@@ -65,23 +62,9 @@ func (t *JWTAuthTransport) RoundTrip(req *http.Request) (*http.Response, error)
 }
 
 func (t *JWTAuthTransport) createQueryStringHash(httpMethod string, jiraURL *url.URL) string {
-	canonicalRequest := t.canonicalizeRequest(httpMethod, jiraURL)
-	h := sha256.Sum256([]byte(canonicalRequest))
-	return hex.EncodeToString(h[:])
+	return core.QueryStringHash(httpMethod, jiraURL)
 }
 
 func (t *JWTAuthTransport) canonicalizeRequest(httpMethod string, jiraURL *url.URL) string {
-	path := "/" + strings.Replace(strings.Trim(jiraURL.Path, "/"), "&", "%26", -1)
-
-	var canonicalQueryString []string
-	for k, v := range jiraURL.Query() {
-		if k == "jwt" {
-			continue
-		}
-		param := url.QueryEscape(k)
-		value := url.QueryEscape(strings.Join(v, ""))
-		canonicalQueryString = append(canonicalQueryString, strings.Replace(strings.Join([]string{param, value}, "="), "+", "%20", -1))
-	}
-	sort.Strings(canonicalQueryString)
-	return fmt.Sprintf("%s&%s&%s", strings.ToUpper(httpMethod), path, strings.Join(canonicalQueryString, "&"))
+	return core.CanonicalizeRequest(httpMethod, jiraURL)
 }
@@ -0,0 +1,446 @@
+package cloud
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Endpoints of the Atlassian OAuth 2.0 (3LO) authorization server and API gateway.
+const (
+	OAuth2AuthURL      = "https://auth.atlassian.com/authorize"
+	OAuth2TokenURL     = "https://auth.atlassian.com/oauth/token"
+	OAuth2ResourcesURL = "https://api.atlassian.com/oauth/token/accessible-resources"
+	OAuth2APIURL       = "https://api.atlassian.com/ex/jira/"
+)
+
+// oauth2ExpiryDelta is subtracted from the token expiry,
+// so that a token is refreshed before Jira starts to reject it.
+const oauth2ExpiryDelta = 10 * time.Second
+
+// OAuth2Config describes an OAuth 2.0 (3LO) app registered in the Atlassian developer console.
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/oauth-2-3lo-apps/
+// Create an app: https://developer.atlassian.com/console/myapps/
+type OAuth2Config struct {
+	ClientID     string
+	ClientSecret string
+
+	// RedirectURL is the callback URL configured for the app.
+	RedirectURL string
+
+	// Scopes to request, e.g. "read:jira-work" and "offline_access".
+	// "offline_access" is required to get a refresh token.
+	Scopes []string
+
+	// AuthURL, TokenURL, ResourcesURL and APIURL default to
+	// OAuth2AuthURL, OAuth2TokenURL, OAuth2ResourcesURL and OAuth2APIURL if empty.
+	AuthURL      string
+	TokenURL     string
+	ResourcesURL string
+	APIURL       string
+
+	// OnTokenRefresh is called with the new token after a token was refreshed,
+	// e.g. to persist it. Atlassian rotates refresh tokens, so the old one can't be used again.
+	OnTokenRefresh func(*OAuth2Token)
+
+	// HTTPClient is used to talk to the authorization server.
+	// It will default to http.DefaultClient if nil.
+	HTTPClient *http.Client
+}
+
+// OAuth2Token is an OAuth 2.0 token issued by the Atlassian authorization server.
+type OAuth2Token struct {
+	AccessToken  string    `json:"access_token"`
+	TokenType    string    `json:"token_type,omitempty"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	Scope        string    `json:"scope,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+}
+
+// Valid reports whether t has an access token that is not (nearly) expired.
+func (t *OAuth2Token) Valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	return t.Expiry.IsZero() || time.Now().Add(oauth2ExpiryDelta).Before(t.Expiry)
+}
+
+// OAuth2TokenSource supplies OAuth 2.0 tokens.
+type OAuth2TokenSource interface {
+	Token(ctx context.Context) (*OAuth2Token, error)
+}
+
+// AccessibleResource is a Jira site an OAuth 2.0 token grants access to.
+type AccessibleResource struct {
+	ID        string   `json:"id"`
+	URL       string   `json:"url"`
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes"`
+	AvatarURL string   `json:"avatarUrl"`
+}
+
+// NewOAuth2Verifier returns a random PKCE code verifier.
+// It has to be passed to AuthCodeURL and, after the user granted access, to Exchange.
+//
+// RFC: https://www.rfc-editor.org/rfc/rfc7636
+func NewOAuth2Verifier() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Sprintf("oauth2: reading random bytes failed: %v", err))
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// oauth2Challenge returns the S256 PKCE code challenge of verifier.
+func oauth2Challenge(verifier string) string {
+	h := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(h[:])
+}
+
+// AuthCodeURL returns the URL of the consent page the user needs to be redirected to.
+// state protects against CSRF and is passed back to the RedirectURL.
+// If verifier is not empty, its S256 challenge is added (PKCE).
+func (c *OAuth2Config) AuthCodeURL(state, verifier string) string {
+	v := url.Values{}
+	v.Set("audience", "api.atlassian.com")
+	v.Set("client_id", c.ClientID)
+	v.Set("scope", strings.Join(c.Scopes, " "))
+	v.Set("redirect_uri", c.RedirectURL)
+	v.Set("state", state)
+	v.Set("response_type", "code")
+	v.Set("prompt", "consent")
+	if verifier != "" {
+		v.Set("code_challenge", oauth2Challenge(verifier))
+		v.Set("code_challenge_method", "S256")
+	}
+
+	authURL := c.authURL()
+	if strings.Contains(authURL, "?") {
+		return authURL + "&" + v.Encode()
+	}
+	return authURL + "?" + v.Encode()
+}
+
+// Exchange converts the authorization code passed to the RedirectURL into a token.
+// verifier is the PKCE code verifier that was passed to AuthCodeURL, or empty.
+func (c *OAuth2Config) Exchange(ctx context.Context, code, verifier string) (*OAuth2Token, error) {
+	body := map[string]string{
+		"grant_type":    "authorization_code",
+		"client_id":     c.ClientID,
+		"client_secret": c.ClientSecret,
+		"code":          code,
+		"redirect_uri":  c.RedirectURL,
+	}
+	if verifier != "" {
+		body["code_verifier"] = verifier
+	}
+	return c.retrieveToken(ctx, body)
+}
+
+// Refresh retrieves a new token with the refresh token of t.
+func (c *OAuth2Config) Refresh(ctx context.Context, t *OAuth2Token) (*OAuth2Token, error) {
+	if t == nil || t.RefreshToken == "" {
+		return nil, errors.New("oauth2: token expired and refresh token is not set")
+	}
+	token, err := c.retrieveToken(ctx, map[string]string{
+		"grant_type":    "refresh_token",
+		"client_id":     c.ClientID,
+		"client_secret": c.ClientSecret,
+		"refresh_token": t.RefreshToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if token.RefreshToken == "" {
+		token.RefreshToken = t.RefreshToken
+	}
+	return token, nil
+}
+
+// TokenSource returns an OAuth2TokenSource that returns t until it expires
+// and refreshes it afterwards. It is safe for concurrent use.
+func (c *OAuth2Config) TokenSource(t *OAuth2Token) OAuth2TokenSource {
+	return &refreshingTokenSource{config: c, token: t}
+}
+
+// AccessibleResources returns the Jira sites the token grants access to.
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/oauth-2-3lo-apps/#3-1-get-the-cloudid-for-your-site
+func (c *OAuth2Config) AccessibleResources(ctx context.Context, t *OAuth2Token) ([]AccessibleResource, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.resourcesURL(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+t.AccessToken)
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, fmt.Errorf("oauth2: fetching accessible resources: %w", err)
+	}
+
+	var resources []AccessibleResource
+	if err := json.NewDecoder(resp.Body).Decode(&resources); err != nil {
+		return nil, fmt.Errorf("oauth2: decoding accessible resources: %w", err)
+	}
+	return resources, nil
+}
+
+func (c *OAuth2Config) retrieveToken(ctx context.Context, body map[string]string) (*OAuth2Token, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.tokenURL(), bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		AccessToken      string `json:"access_token"`
+		TokenType        string `json:"token_type"`
+		RefreshToken     string `json:"refresh_token"`
+		Scope            string `json:"scope"`
+		ExpiresIn        int    `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil && resp.StatusCode == http.StatusOK {
+		return nil, fmt.Errorf("oauth2: decoding token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK || result.Error != "" {
+		return nil, &OAuth2Error{StatusCode: resp.StatusCode, Code: result.Error, Description: result.ErrorDescription}
+	}
+	if result.AccessToken == "" {
+		return nil, errors.New("oauth2: server response is missing access_token")
+	}
+
+	token := &OAuth2Token{
+		AccessToken:  result.AccessToken,
+		TokenType:    result.TokenType,
+		RefreshToken: result.RefreshToken,
+		Scope:        result.Scope,
+	}
+	if result.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(result.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+func (c *OAuth2Config) authURL() string {
+	if c.AuthURL != "" {
+		return c.AuthURL
+	}
+	return OAuth2AuthURL
+}
+
+func (c *OAuth2Config) tokenURL() string {
+	if c.TokenURL != "" {
+		return c.TokenURL
+	}
+	return OAuth2TokenURL
+}
+
+func (c *OAuth2Config) resourcesURL() string {
+	if c.ResourcesURL != "" {
+		return c.ResourcesURL
+	}
+	return OAuth2ResourcesURL
+}
+
+func (c *OAuth2Config) apiURL() string {
+	apiURL := OAuth2APIURL
+	if c.APIURL != "" {
+		apiURL = c.APIURL
+	}
+	return strings.TrimRight(apiURL, "/") + "/"
+}
+
+func (c *OAuth2Config) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+// OAuth2Error is returned if the authorization server rejects a token request.
+type OAuth2Error struct {
+	StatusCode  int
+	Code        string
+	Description string
+}
+
+func (e *OAuth2Error) Error() string {
+	if e.Description != "" {
+		return fmt.Sprintf("oauth2: %s: %s (status code %d)", e.Code, e.Description, e.StatusCode)
+	}
+	return fmt.Sprintf("oauth2: token request failed: %s (status code %d)", e.Code, e.StatusCode)
+}
+
+type refreshingTokenSource struct {
+	config *OAuth2Config
+
+	mu    sync.Mutex
+	token *OAuth2Token
+}
+
+func (s *refreshingTokenSource) Token(ctx context.Context) (*OAuth2Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.Valid() {
+		return s.token, nil
+	}
+	token, err := s.config.Refresh(ctx, s.token)
+	if err != nil {
+		return nil, err
+	}
+	s.token = token
+	if s.config.OnTokenRefresh != nil {
+		s.config.OnTokenRefresh(token)
+	}
+	return token, nil
+}
+
+// OAuth2Transport is an http.RoundTripper that authenticates all requests
+// with an OAuth 2.0 (3LO) access token.
+//
+// OAuth 2.0 apps can't call the Jira site directly, but need to go through the API gateway
+// at https://api.atlassian.com/ex/jira/{cloudId}/. The transport rewrites all requests accordingly,
+// so that the client can be created with the URL of the site:
+//
+//	config := &jira.OAuth2Config{ClientID: "...", ClientSecret: "...", RedirectURL: "...", Scopes: []string{"read:jira-work", "offline_access"}}
+//	token, err := config.Exchange(ctx, code, verifier)
+//	tp := &jira.OAuth2Transport{Config: config, Source: config.TokenSource(token)}
+//	client, err := jira.NewClient("https://your.atlassian.net/", tp.Client())
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/oauth-2-3lo-apps/
+type OAuth2Transport struct {
+	Config *OAuth2Config
+	Source OAuth2TokenSource
+
+	// CloudID of the Jira site. If empty, it is discovered via the accessible resources
+	// of the token, by matching the host of the request against the URL of the resources.
+	CloudID string
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	mu       sync.Mutex
+	cloudIDs map[string]string
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *OAuth2Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Source == nil {
+		return nil, errors.New("oauth2: Source is nil")
+	}
+	token, err := t.Source.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	req2 := cloneRequest(req) // per RoundTripper contract
+
+	apiURL, err := url.Parse(t.config().apiURL())
+	if err != nil {
+		return nil, err
+	}
+	if req.URL.Host != apiURL.Host {
+		cloudID, err := t.cloudID(req.Context(), token, req.URL)
+		if err != nil {
+			return nil, err
+		}
+		u := *apiURL
+		u.Path = apiURL.Path + cloudID + "/" + strings.TrimLeft(req.URL.Path, "/")
+		u.RawPath = ""
+		u.RawQuery = req.URL.RawQuery
+		req2.URL = &u
+		req2.Host = ""
+	}
+
+	tokenType := token.TokenType
+	if tokenType == "" || strings.EqualFold(tokenType, "bearer") {
+		tokenType = "Bearer"
+	}
+	req2.Header.Set("Authorization", tokenType+" "+token.AccessToken)
+	return t.transport().RoundTrip(req2)
+}
+
+// Client returns an *http.Client that makes requests that are authenticated
+// using OAuth 2.0 (3LO).
+func (t *OAuth2Transport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *OAuth2Transport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+func (t *OAuth2Transport) config() *OAuth2Config {
+	if t.Config != nil {
+		return t.Config
+	}
+	return &OAuth2Config{}
+}
+
+// cloudID returns the cloudId of the site siteURL points to.
+func (t *OAuth2Transport) cloudID(ctx context.Context, token *OAuth2Token, siteURL *url.URL) (string, error) {
+	if t.CloudID != "" {
+		return t.CloudID, nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if id, ok := t.cloudIDs[siteURL.Host]; ok {
+		return id, nil
+	}
+
+	resources, err := t.config().AccessibleResources(ctx, token)
+	if err != nil {
+		return "", err
+	}
+	for _, r := range resources {
+		u, err := url.Parse(r.URL)
+		if err != nil {
+			continue
+		}
+		if strings.EqualFold(u.Host, siteURL.Host) {
+			if t.cloudIDs == nil {
+				t.cloudIDs = make(map[string]string)
+			}
+			t.cloudIDs[siteURL.Host] = r.ID
+			return r.ID, nil
+		}
+	}
+	return "", fmt.Errorf("oauth2: token grants no access to site %s", siteURL.Host)
+}
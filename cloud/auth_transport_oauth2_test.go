@@ -0,0 +1,264 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func testOAuth2Config() *OAuth2Config {
+	return &OAuth2Config{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RedirectURL:  "https://app.example.com/callback",
+		Scopes:       []string{"read:jira-work", "offline_access"},
+		AuthURL:      testServer.URL + "/authorize",
+		TokenURL:     testServer.URL + "/oauth/token",
+		ResourcesURL: testServer.URL + "/oauth/token/accessible-resources",
+		APIURL:       testServer.URL + "/ex/jira/",
+	}
+}
+
+func TestOAuth2Config_AuthCodeURL(t *testing.T) {
+	config := &OAuth2Config{
+		ClientID:    "client-id",
+		RedirectURL: "https://app.example.com/callback",
+		Scopes:      []string{"read:jira-work", "offline_access"},
+	}
+	verifier := NewOAuth2Verifier()
+
+	u, err := url.Parse(config.AuthCodeURL("state-1", verifier))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got := u.Scheme + "://" + u.Host + u.Path; got != OAuth2AuthURL {
+		t.Errorf("Expected auth URL %s, got %s", OAuth2AuthURL, got)
+	}
+
+	q := u.Query()
+	want := map[string]string{
+		"audience":              "api.atlassian.com",
+		"client_id":             "client-id",
+		"scope":                 "read:jira-work offline_access",
+		"redirect_uri":          "https://app.example.com/callback",
+		"state":                 "state-1",
+		"response_type":         "code",
+		"prompt":                "consent",
+		"code_challenge":        oauth2Challenge(verifier),
+		"code_challenge_method": "S256",
+	}
+	for k, v := range want {
+		if q.Get(k) != v {
+			t.Errorf("Expected query parameter %s=%q, got %q", k, v, q.Get(k))
+		}
+	}
+}
+
+func TestOAuth2Challenge(t *testing.T) {
+	// Example of RFC 7636, Appendix B
+	got := oauth2Challenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk")
+	if want := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"; got != want {
+		t.Errorf("Expected challenge %s, got %s", want, got)
+	}
+}
+
+func TestOAuth2Config_Exchange(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["grant_type"] != "authorization_code" || body["code"] != "the-code" || body["code_verifier"] != "the-verifier" {
+			t.Errorf("Unexpected token request: %v", body)
+		}
+		if body["client_id"] != "client-id" || body["client_secret"] != "client-secret" {
+			t.Errorf("Unexpected client credentials: %v", body)
+		}
+		fmt.Fprint(w, `{"access_token":"access-1","refresh_token":"refresh-1","token_type":"Bearer","expires_in":3600,"scope":"read:jira-work"}`)
+	})
+
+	token, err := testOAuth2Config().Exchange(context.Background(), "the-code", "the-verifier")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if token.AccessToken != "access-1" || token.RefreshToken != "refresh-1" {
+		t.Errorf("Unexpected token: %+v", token)
+	}
+	if !token.Valid() {
+		t.Errorf("Expected token to be valid")
+	}
+}
+
+func TestOAuth2Config_Exchange_Error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"error":"invalid_grant","error_description":"Invalid authorization code"}`)
+	})
+
+	_, err := testOAuth2Config().Exchange(context.Background(), "the-code", "")
+	oerr, ok := err.(*OAuth2Error)
+	if !ok {
+		t.Fatalf("Expected an *OAuth2Error, got %v", err)
+	}
+	if oerr.Code != "invalid_grant" || oerr.StatusCode != http.StatusForbidden {
+		t.Errorf("Unexpected error: %+v", oerr)
+	}
+}
+
+func TestOAuth2Config_TokenSource_Refresh(t *testing.T) {
+	setup()
+	defer teardown()
+
+	refreshes := 0
+	testMux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["grant_type"] != "refresh_token" || body["refresh_token"] != "refresh-1" {
+			t.Errorf("Unexpected refresh request: %v", body)
+		}
+		refreshes++
+		fmt.Fprint(w, `{"access_token":"access-2","refresh_token":"refresh-2","expires_in":3600}`)
+	})
+
+	var refreshed *OAuth2Token
+	config := testOAuth2Config()
+	config.OnTokenRefresh = func(t *OAuth2Token) { refreshed = t }
+
+	expired := &OAuth2Token{AccessToken: "access-1", RefreshToken: "refresh-1", Expiry: time.Now().Add(-time.Minute)}
+	ts := config.TokenSource(expired)
+
+	for i := 0; i < 2; i++ {
+		token, err := ts.Token(context.Background())
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		if token.AccessToken != "access-2" {
+			t.Errorf("Expected refreshed access token, got %s", token.AccessToken)
+		}
+	}
+	if refreshes != 1 {
+		t.Errorf("Expected 1 refresh, got %d", refreshes)
+	}
+	if refreshed == nil || refreshed.RefreshToken != "refresh-2" {
+		t.Errorf("Expected OnTokenRefresh to be called with the new token, got %+v", refreshed)
+	}
+}
+
+func TestOAuth2Config_TokenSource_NoRefreshToken(t *testing.T) {
+	expired := &OAuth2Token{AccessToken: "access-1", Expiry: time.Now().Add(-time.Minute)}
+	if _, err := (&OAuth2Config{}).TokenSource(expired).Token(context.Background()); err == nil {
+		t.Error("Expected an error without refresh token")
+	}
+}
+
+func TestOAuth2Transport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	resourceCalls := 0
+	testMux.HandleFunc("/oauth/token/accessible-resources", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		resourceCalls++
+		if got := r.Header.Get("Authorization"); got != "Bearer access-1" {
+			t.Errorf("Expected bearer token, got %q", got)
+		}
+		fmt.Fprint(w, `[
+			{"id":"other-id","url":"https://other.atlassian.net","name":"other"},
+			{"id":"cloud-id","url":"https://your.atlassian.net","name":"your","scopes":["read:jira-work"]}
+		]`)
+	})
+	testMux.HandleFunc("/ex/jira/cloud-id/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"fields": "summary"})
+		if got := r.Header.Get("Authorization"); got != "Bearer access-1" {
+			t.Errorf("Expected bearer token, got %q", got)
+		}
+		fmt.Fprint(w, `{"id":"10001","key":"EX-1"}`)
+	})
+
+	config := testOAuth2Config()
+	tp := &OAuth2Transport{
+		Config: config,
+		Source: config.TokenSource(&OAuth2Token{AccessToken: "access-1", TokenType: "bearer"}),
+	}
+	c, _ := NewClient("https://your.atlassian.net/", tp.Client())
+
+	for i := 0; i < 2; i++ {
+		issue, _, err := c.Issue.Get(context.Background(), "EX-1", &GetQueryOptions{Fields: "summary"})
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		if issue.Key != "EX-1" {
+			t.Errorf("Expected issue EX-1, got %s", issue.Key)
+		}
+	}
+	if resourceCalls != 1 {
+		t.Errorf("Expected the cloudId to be discovered once, got %d calls", resourceCalls)
+	}
+}
+
+func TestOAuth2Transport_UnknownSite(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/oauth/token/accessible-resources", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":"other-id","url":"https://other.atlassian.net"}]`)
+	})
+
+	config := testOAuth2Config()
+	tp := &OAuth2Transport{
+		Config: config,
+		Source: config.TokenSource(&OAuth2Token{AccessToken: "access-1"}),
+	}
+	c, _ := NewClient("https://your.atlassian.net/", tp.Client())
+
+	if _, _, err := c.Issue.Get(context.Background(), "EX-1", nil); err == nil {
+		t.Error("Expected an error for a site the token grants no access to")
+	}
+}
+
+func TestOAuth2Transport_CloudID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/ex/jira/fixed-id/rest/api/3/myself", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"accountId":"1"}`)
+	})
+
+	config := testOAuth2Config()
+	tp := &OAuth2Transport{
+		Config:  config,
+		Source:  config.TokenSource(&OAuth2Token{AccessToken: "access-1"}),
+		CloudID: "fixed-id",
+	}
+	c, _ := NewClient("https://your.atlassian.net/", tp.Client())
+
+	if _, _, err := c.User.GetCurrentUser(context.Background()); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestOAuth2Transport_transport(t *testing.T) {
+	// default transport
+	tp := &OAuth2Transport{}
+	if tp.transport() != http.DefaultTransport {
+		t.Errorf("Expected http.DefaultTransport to be used.")
+	}
+
+	// custom transport
+	tp = &OAuth2Transport{
+		Transport: &http.Transport{},
+	}
+	if tp.transport() == http.DefaultTransport {
+		t.Errorf("Expected custom transport to be used.")
+	}
+}
@@ -0,0 +1,26 @@
+package cloud
+
+import "github.com/andygrunwald/go-jira/v2/internal/core"
+
+// DefaultCacheSize is the number of responses kept by the LRUCache
+// that a CacheTransport creates if no Cache has been configured.
+const DefaultCacheSize = core.DefaultCacheSize
+
+// CacheEntry represents a cached API response.
+type CacheEntry = core.CacheEntry
+
+// Cache is a storage for API responses used by the CacheTransport.
+// Implement this interface to plug in a shared cache (like Redis or memcached).
+// Implementations must be safe for concurrent use.
+// A Cache shared between users needs a distinct CacheTransport.Partition per user.
+type Cache = core.Cache
+
+// LRUCache is an in-memory Cache that evicts the least recently used
+// entry once it holds more than its configured capacity.
+type LRUCache = core.LRUCache
+
+// NewLRUCache returns an LRUCache that holds up to capacity entries.
+// A capacity <= 0 falls back to DefaultCacheSize.
+func NewLRUCache(capacity int) *LRUCache {
+	return core.NewLRUCache(capacity)
+}
@@ -0,0 +1,78 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCacheTransport_CachesMetadataEndpoints(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	testMux.HandleFunc("/rest/api/2/priority", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		calls++
+		fmt.Fprint(w, `[{"id":"1","name":"Blocker"}]`)
+	})
+
+	tp := &CacheTransport{}
+	c, _ := NewClient(testServer.URL, tp.Client())
+
+	for i := 0; i < 3; i++ {
+		priorities, _, err := c.Priority.GetList(context.Background())
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		if len(priorities) != 1 || priorities[0].Name != "Blocker" {
+			t.Errorf("Unexpected priorities: %+v", priorities)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 call to Jira, got %d", calls)
+	}
+}
+
+func TestCacheTransport_Revalidate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls, notModified := 0, 0
+	testMux.HandleFunc("/rest/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `[{"id":"summary","name":"Summary"}]`)
+	})
+
+	tp := &CacheTransport{
+		Rules: []CacheRule{{Path: "rest/api/2/field", TTL: time.Nanosecond}},
+	}
+	c, _ := NewClient(testServer.URL, tp.Client())
+
+	c.Field.GetList(context.Background())
+	time.Sleep(time.Millisecond)
+	fields, resp, err := c.Field.GetList(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(fields) != 1 || fields[0].ID != "summary" {
+		t.Errorf("Unexpected fields: %+v", fields)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	}
+	if resp.Header.Get(CacheHeader) == "" {
+		t.Errorf("Expected response to be served from the cache")
+	}
+	if calls != 2 || notModified != 1 {
+		t.Errorf("Expected 2 calls with 1 revalidation, got %d calls and %d revalidations", calls, notModified)
+	}
+}
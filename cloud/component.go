@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+
+	"github.com/andygrunwald/go-jira/v2/internal/core"
 )
 
 // ComponentService represents project components.
@@ -54,6 +56,38 @@ type ComponentCreateOptions struct {
 	Project string `json:"project,omitempty" structs:"project,omitempty"`
 }
 
+// ComponentDeleteOptions specifies the optional parameters of ComponentService.Delete.
+type ComponentDeleteOptions struct {
+	// MoveIssuesTo is the ID of the component the issues of the deleted component are moved to.
+	MoveIssuesTo string `url:"moveIssuesTo,omitempty"`
+}
+
+// ComponentListOptions specifies the optional parameters of ComponentService.ListByProjectPaginated.
+type ComponentListOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+
+	// OrderBy: e.g. "name", "-issueCount", "lead" or "description".
+	OrderBy string `url:"orderBy,omitempty"`
+
+	// Query: Matches the name or description of the component, case insensitive.
+	Query string `url:"query,omitempty"`
+}
+
+// componentListResult is only a small wrapper around the ListByProjectPaginated method
+// to be able to parse the results
+type componentListResult struct {
+	StartAt    int                `json:"startAt"`
+	MaxResults int                `json:"maxResults"`
+	Total      int                `json:"total"`
+	IsLast     bool               `json:"isLast"`
+	Values     []ProjectComponent `json:"values"`
+}
+
+func (r *componentListResult) Page() core.Page {
+	return core.Page{StartAt: r.StartAt, MaxResults: r.MaxResults, Total: r.Total, IsLast: r.IsLast}
+}
+
 // Create creates a component.
 // Use components to provide containers for issues within a project.
 //
@@ -93,12 +127,118 @@ func (s *ComponentService) Get(ctx context.Context, componentID string) (*Projec
 	return component, resp, nil
 }
 
-// TODO Add "Update component" method. See https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-id-put
+// Update updates a component.
+// Only the set options are updated, the project can't be changed.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-id-put
+func (s *ComponentService) Update(ctx context.Context, componentID string, options *ComponentCreateOptions) (*ProjectComponent, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/component/%s", componentID)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	component := new(ProjectComponent)
+	resp, err := s.client.Do(req, component)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return component, resp, nil
+}
+
+// Delete deletes a component.
+// The issues of the component are moved to the component options.MoveIssuesTo, if set.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-id-delete
+func (s *ComponentService) Delete(ctx context.Context, componentID string, options *ComponentDeleteOptions) (*Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/api/3/component/%s", componentID), options)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// GetRelatedIssueCounts returns the number of issues assigned to a component.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-id-relatedissuecounts-get
+func (s *ComponentService) GetRelatedIssueCounts(ctx context.Context, componentID string) (int, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/component/%s/relatedIssueCounts", componentID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	result := struct {
+		IssueCount int `json:"issueCount"`
+	}{}
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return 0, resp, NewJiraError(resp, err)
+	}
+
+	return result.IssueCount, resp, nil
+}
+
+// ListByProject returns all components of a project.
+// Use ListByProjectPaginated for projects with many components.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-project-projectidorkey-components-get
+func (s *ComponentService) ListByProject(ctx context.Context, projectID string) ([]ProjectComponent, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/project/%s/components", projectID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var components []ProjectComponent
+	resp, err := s.client.Do(req, &components)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return components, resp, nil
+}
 
-// TODO Add "Delete component" method. See https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-id-delete
+// ListByProjectPaginated returns a page of the components of a project, including their IssueCount.
+// The paging values are set in the Response.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-project-projectidorkey-component-get
+func (s *ComponentService) ListByProjectPaginated(ctx context.Context, projectID string, options *ComponentListOptions) ([]ProjectComponent, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/api/3/project/%s/component", projectID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
 
-// TODO Add "Get component issues count" method. See https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-id-relatedissuecounts-get
+	result := new(componentListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
 
-// TODO Add "Get project components paginated" method. See https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-project-projectidorkey-component-get
+	return result.Values, resp, nil
+}
 
-// TODO Add "Get project components" method. See https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-project-projectidorkey-components-get
+// ResolvedAssignee returns the user that issues created with the component are assigned to,
+// as resolved by Jira from the AssigneeType (see RealAssigneeType and RealAssignee).
+// It returns nil if the issues are unassigned.
+func (c *ProjectComponent) ResolvedAssignee() *User {
+	if c.RealAssigneeType == AssigneeTypeUnassigned || (c.RealAssignee.AccountID == "" && c.RealAssignee.Name == "") {
+		return nil
+	}
+	return &c.RealAssignee
+}
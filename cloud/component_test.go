@@ -2,6 +2,7 @@ package cloud
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
@@ -79,3 +80,121 @@ func TestComponentService_Get_NoComponent(t *testing.T) {
 		t.Error("No error given. Expected one")
 	}
 }
+
+func TestComponentService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/3/component/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body) != 1 || body["assigneeType"] != AssigneeTypeComponentLead {
+			t.Errorf("Unexpected request body: %v", body)
+		}
+		fmt.Fprint(w, `{"id":"10000","name":"Component 1","assigneeType":"COMPONENT_LEAD"}`)
+	})
+
+	component, _, err := testClient.Component.Update(context.Background(), "10000", &ComponentCreateOptions{AssigneeType: AssigneeTypeComponentLead})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if component.AssigneeType != AssigneeTypeComponentLead {
+		t.Errorf("Unexpected component: %+v", component)
+	}
+}
+
+func TestComponentService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/3/component/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, "/rest/api/3/component/10000?moveIssuesTo=10001")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Component.Delete(context.Background(), "10000", &ComponentDeleteOptions{MoveIssuesTo: "10001"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestComponentService_GetRelatedIssueCounts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/3/component/10000/relatedIssueCounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/3/component/10000","issueCount":23}`)
+	})
+
+	count, _, err := testClient.Component.GetRelatedIssueCounts(context.Background(), "10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if count != 23 {
+		t.Errorf("Expected 23 issues, got %d", count)
+	}
+}
+
+func TestComponentService_ListByProject(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/3/project/EX/components", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":"10000","name":"Component 1"},{"id":"10050","name":"PXA"}]`)
+	})
+
+	components, _, err := testClient.Component.ListByProject(context.Background(), "EX")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(components) != 2 || components[1].Name != "PXA" {
+		t.Errorf("Unexpected components: %+v", components)
+	}
+}
+
+func TestProjectComponent_ResolvedAssignee(t *testing.T) {
+	component := &ProjectComponent{
+		AssigneeType:        AssigneeTypeComponentLead,
+		IsAssigneeTypeValid: false,
+		RealAssigneeType:    AssigneeTypeProjectLead,
+		RealAssignee:        User{AccountID: "fred"},
+	}
+	if assignee := component.ResolvedAssignee(); assignee == nil || assignee.AccountID != "fred" {
+		t.Errorf("Expected the project lead fred, got %+v", assignee)
+	}
+
+	component = &ProjectComponent{AssigneeType: AssigneeTypeUnassigned, RealAssigneeType: AssigneeTypeUnassigned}
+	if assignee := component.ResolvedAssignee(); assignee != nil {
+		t.Errorf("Expected no assignee, got %+v", assignee)
+	}
+}
+
+func TestComponentService_ListByProjectPaginated(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/3/project/EX/component", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/3/project/EX/component?maxResults=1&orderBy=-issueCount&query=api&startAt=1")
+		fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"isLast":true,"values":[{"id":"10050","name":"API","issueCount":12}]}`)
+	})
+
+	components, resp, err := testClient.Component.ListByProjectPaginated(context.Background(), "EX", &ComponentListOptions{
+		StartAt:    1,
+		MaxResults: 1,
+		OrderBy:    "-issueCount",
+		Query:      "api",
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(components) != 1 || components[0].IssueCount != 12 {
+		t.Errorf("Unexpected components: %+v", components)
+	}
+	if resp.StartAt != 1 || resp.Total != 2 || !resp.IsLast {
+		t.Errorf("Unexpected paging values: %+v", resp)
+	}
+}
@@ -0,0 +1,243 @@
+// Package connect implements the inbound side of Atlassian Connect apps for Jira Cloud:
+// the lifecycle callbacks (installed, uninstalled, enabled, disabled), a TenantStore
+// for the shared secrets of the installations and a middleware verifying
+// the JWTs of requests sent by Jira.
+//
+//	app := &connect.App{Key: "com.example.app", BaseURL: "https://app.example.com", Store: store}
+//	http.Handle("/installed", app.LifecycleHandler())
+//	http.Handle("/uninstalled", app.LifecycleHandler())
+//	http.Handle("/issue-glance", app.Middleware(glanceHandler))
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/connect-app-descriptor/#lifecycle
+package connect
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// DefaultKeyServerURL is the server of the public keys signing the install and uninstall callbacks.
+const DefaultKeyServerURL = "https://connect-install-keys.atlassian.com"
+
+// Lifecycle event types.
+const (
+	EventInstalled   = "installed"
+	EventUninstalled = "uninstalled"
+	EventEnabled     = "enabled"
+	EventDisabled    = "disabled"
+)
+
+// maxPayloadSize limits the size of accepted lifecycle payloads.
+const maxPayloadSize = 1 << 20
+
+// App is an Atlassian Connect app.
+type App struct {
+	// Key is the key of the app descriptor. It is the issuer of the JWTs of the app.
+	Key string
+
+	// BaseURL is the baseUrl of the app descriptor, e.g. "https://app.example.com".
+	// It is the audience of the install JWTs and the root of the paths of the query string hashes.
+	BaseURL string
+
+	// Store persists the tenants.
+	// It will default to an in-memory store if nil.
+	Store TenantStore
+
+	// KeyServerURL is the server of the public keys verifying install JWTs.
+	// It will default to DefaultKeyServerURL if empty.
+	KeyServerURL string
+
+	// HTTPClient fetches the public keys.
+	// It will default to http.DefaultClient if nil.
+	HTTPClient *http.Client
+
+	// AllowContextQSH accepts JWTs with the qsh claim "context-qsh", which Jira uses
+	// for tokens of the JavaScript API (AP.context.getToken) not bound to a request.
+	AllowContextQSH bool
+
+	// OnInstalled, OnUninstalled, OnEnabled and OnDisabled are called with the tenant
+	// of a verified lifecycle callback. OnInstalled, OnEnabled and OnDisabled are called
+	// before the tenant is saved, OnUninstalled before it is deleted.
+	// An error aborts the callback with 500 Internal Server Error.
+	OnInstalled   func(ctx context.Context, tenant *Tenant) error
+	OnUninstalled func(ctx context.Context, tenant *Tenant) error
+	OnEnabled     func(ctx context.Context, tenant *Tenant) error
+	OnDisabled    func(ctx context.Context, tenant *Tenant) error
+
+	// ErrorLog is called with errors of the lifecycle callbacks and the store.
+	// Errors are not logged if nil.
+	ErrorLog func(err error)
+
+	mu        sync.Mutex
+	store     TenantStore
+	publicKey map[string]*rsa.PublicKey
+}
+
+// LifecyclePayload is the body of a lifecycle callback.
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/connect-app-descriptor/#lifecycle-http-request-payload
+type LifecyclePayload struct {
+	Key                      string `json:"key"`
+	ClientKey                string `json:"clientKey"`
+	OAuthClientID            string `json:"oauthClientId,omitempty"`
+	SharedSecret             string `json:"sharedSecret,omitempty"`
+	BaseURL                  string `json:"baseUrl"`
+	DisplayURL               string `json:"displayUrl,omitempty"`
+	ProductType              string `json:"productType"`
+	Description              string `json:"description"`
+	ServiceEntitlementNumber string `json:"serviceEntitlementNumber,omitempty"`
+	EventType                string `json:"eventType"`
+	CloudID                  string `json:"cloudId,omitempty"`
+}
+
+// LifecycleHandler returns an http.Handler for all lifecycle callbacks.
+// The event type is taken from the payload, so the same handler can be registered
+// for the installed, uninstalled, enabled and disabled URLs of the app descriptor.
+//
+// The installed and uninstalled callbacks are verified with the RS256 JWT signed by Atlassian
+// (see KeyServerURL), the enabled and disabled callbacks with the shared secret of the tenant.
+// Verification failures are answered with 401 Unauthorized.
+func (a *App) LifecycleHandler() http.Handler {
+	return http.HandlerFunc(a.serveLifecycle)
+}
+
+func (a *App) serveLifecycle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		http.Error(w, "reading payload failed", http.StatusBadRequest)
+		return
+	}
+	payload := new(LifecyclePayload)
+	if err := json.Unmarshal(body, payload); err != nil || payload.ClientKey == "" {
+		http.Error(w, "invalid lifecycle payload", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	var tenant *Tenant
+	switch payload.EventType {
+	case EventInstalled, EventUninstalled:
+		if err := a.verifyInstallRequest(ctx, r, payload.ClientKey); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		tenant, err = a.tenantStore().Get(ctx, payload.ClientKey)
+		if errors.Is(err, ErrTenantNotFound) {
+			tenant, err = &Tenant{ClientKey: payload.ClientKey}, nil
+		}
+	case EventEnabled, EventDisabled:
+		tenant, _, err = a.verifyRequest(r)
+		if err == nil && tenant.ClientKey != payload.ClientKey {
+			err = fmt.Errorf("connect: JWT issuer %q doesn't match client key %q", tenant.ClientKey, payload.ClientKey)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+	default:
+		http.Error(w, fmt.Sprintf("unknown lifecycle event %q", payload.EventType), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		a.logError(err)
+		http.Error(w, "loading tenant failed", http.StatusInternalServerError)
+		return
+	}
+
+	tenant.BaseURL = payload.BaseURL
+	tenant.DisplayURL = payload.DisplayURL
+	tenant.CloudID = payload.CloudID
+	tenant.OAuthClientID = payload.OAuthClientID
+	if payload.SharedSecret != "" {
+		tenant.SharedSecret = payload.SharedSecret
+	}
+
+	if err := a.handleLifecycle(ctx, payload.EventType, tenant); err != nil {
+		a.logError(fmt.Errorf("connect: %s callback of %s: %w", payload.EventType, payload.ClientKey, err))
+		http.Error(w, "handling lifecycle event failed", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (a *App) handleLifecycle(ctx context.Context, eventType string, tenant *Tenant) error {
+	switch eventType {
+	case EventInstalled:
+		tenant.Enabled = true
+		if err := call(ctx, a.OnInstalled, tenant); err != nil {
+			return err
+		}
+	case EventEnabled:
+		tenant.Enabled = true
+		if err := call(ctx, a.OnEnabled, tenant); err != nil {
+			return err
+		}
+	case EventDisabled:
+		tenant.Enabled = false
+		if err := call(ctx, a.OnDisabled, tenant); err != nil {
+			return err
+		}
+	case EventUninstalled:
+		if err := call(ctx, a.OnUninstalled, tenant); err != nil {
+			return err
+		}
+		return a.tenantStore().Delete(ctx, tenant.ClientKey)
+	}
+	return a.tenantStore().Save(ctx, tenant)
+}
+
+// Client returns a Jira Cloud client for the tenant,
+// authenticating as the app with a JWT signed by the shared secret.
+func (a *App) Client(tenant *Tenant) (*cloud.Client, error) {
+	tp := &cloud.JWTAuthTransport{
+		Secret: []byte(tenant.SharedSecret),
+		Issuer: a.Key,
+	}
+	return cloud.NewClient(tenant.BaseURL, tp.Client())
+}
+
+func (a *App) tenantStore() TenantStore {
+	if a.Store != nil {
+		return a.Store
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.store == nil {
+		a.store = &MemoryTenantStore{}
+	}
+	return a.store
+}
+
+func (a *App) logError(err error) {
+	if a.ErrorLog != nil {
+		a.ErrorLog(err)
+	}
+}
+
+func call(ctx context.Context, f func(context.Context, *Tenant) error, tenant *Tenant) error {
+	if f == nil {
+		return nil
+	}
+	return f(ctx, tenant)
+}
+
+// trimBaseURL removes the trailing slash of a base URL.
+func trimBaseURL(baseURL string) string {
+	return strings.TrimSuffix(baseURL, "/")
+}
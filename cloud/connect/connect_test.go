@@ -0,0 +1,301 @@
+package connect
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/andygrunwald/go-jira/v2/internal/core"
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	testAppBaseURL = "https://app.example.com/connect"
+	testClientKey  = "client-key"
+	testSecret     = "shared-secret"
+	testKeyID      = "key-1"
+)
+
+var (
+	testInstallKeyOnce sync.Once
+	testInstallKey     *rsa.PrivateKey
+)
+
+func installKey(t *testing.T) *rsa.PrivateKey {
+	testInstallKeyOnce.Do(func() {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatalf("Error generating RSA key: %s", err)
+		}
+		testInstallKey = key
+	})
+	return testInstallKey
+}
+
+// keyServer stubs the Atlassian key server serving the public key testKeyID.
+func keyServer(t *testing.T) *httptest.Server {
+	b, err := x509.MarshalPKIXPublicKey(&installKey(t).PublicKey)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	publicKey := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: b})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+testKeyID {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(publicKey)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// signedRequest returns a request to target (relative to the app base URL) signed
+// with a JWT of the claims, including the query string hash of the request.
+func signedRequest(t *testing.T, method, target, body string, alg jwt.SigningMethod, key interface{}, claims jwt.MapClaims) *http.Request {
+	u, _ := url.Parse(target)
+	if _, ok := claims["qsh"]; !ok {
+		claims["qsh"] = core.QueryStringHash(method, u)
+	}
+	if _, ok := claims["exp"]; !ok {
+		claims["exp"] = time.Now().Add(time.Minute).Unix()
+	}
+	token := jwt.NewWithClaims(alg, claims)
+	token.Header["kid"] = testKeyID
+	s, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("Error signing JWT: %s", err)
+	}
+
+	req := httptest.NewRequest(method, "/connect"+target, strings.NewReader(body))
+	req.Header.Set("Authorization", "JWT "+s)
+	return req
+}
+
+func lifecyclePayload(eventType string) string {
+	return fmt.Sprintf(`{"key":"com.example.app","clientKey":%q,"sharedSecret":%q,"baseUrl":"https://example.atlassian.net","productType":"jira","eventType":%q}`,
+		testClientKey, testSecret, eventType)
+}
+
+func installRequest(t *testing.T, eventType string, claims jwt.MapClaims) *http.Request {
+	return signedRequest(t, http.MethodPost, "/"+eventType, lifecyclePayload(eventType), jwt.SigningMethodRS256, installKey(t), claims)
+}
+
+func newTestApp(t *testing.T) *App {
+	return &App{
+		Key:          "com.example.app",
+		BaseURL:      testAppBaseURL,
+		Store:        &MemoryTenantStore{},
+		KeyServerURL: keyServer(t).URL,
+	}
+}
+
+func serve(h http.Handler, req *http.Request) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestApp_Lifecycle(t *testing.T) {
+	app := newTestApp(t)
+	ctx := context.Background()
+
+	var installed *Tenant
+	app.OnInstalled = func(ctx context.Context, tenant *Tenant) error {
+		installed = tenant
+		return nil
+	}
+
+	rec := serve(app.LifecycleHandler(), installRequest(t, EventInstalled, jwt.MapClaims{"iss": testClientKey, "aud": testAppBaseURL}))
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("Expected status 204, got %d: %s", rec.Code, rec.Body)
+	}
+	if installed == nil || installed.BaseURL != "https://example.atlassian.net" {
+		t.Errorf("Unexpected installed tenant: %+v", installed)
+	}
+	tenant, err := app.Store.Get(ctx, testClientKey)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if tenant.SharedSecret != testSecret || !tenant.Enabled {
+		t.Errorf("Unexpected stored tenant: %+v", tenant)
+	}
+
+	// disabled is signed with the shared secret
+	req := signedRequest(t, http.MethodPost, "/disabled", lifecyclePayload(EventDisabled), jwt.SigningMethodHS256, []byte(testSecret), jwt.MapClaims{"iss": testClientKey})
+	if rec := serve(app.LifecycleHandler(), req); rec.Code != http.StatusNoContent {
+		t.Fatalf("Expected status 204, got %d: %s", rec.Code, rec.Body)
+	}
+	if tenant, _ := app.Store.Get(ctx, testClientKey); tenant.Enabled {
+		t.Error("Expected the tenant to be disabled")
+	}
+
+	// enabled is sent by the disabled tenant
+	req = signedRequest(t, http.MethodPost, "/enabled", lifecyclePayload(EventEnabled), jwt.SigningMethodHS256, []byte(testSecret), jwt.MapClaims{"iss": testClientKey})
+	if rec := serve(app.LifecycleHandler(), req); rec.Code != http.StatusNoContent {
+		t.Fatalf("Expected status 204, got %d: %s", rec.Code, rec.Body)
+	}
+	if tenant, _ := app.Store.Get(ctx, testClientKey); !tenant.Enabled {
+		t.Error("Expected the tenant to be enabled")
+	}
+
+	rec = serve(app.LifecycleHandler(), installRequest(t, EventUninstalled, jwt.MapClaims{"iss": testClientKey, "aud": testAppBaseURL}))
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("Expected status 204, got %d: %s", rec.Code, rec.Body)
+	}
+	if _, err := app.Store.Get(ctx, testClientKey); !errors.Is(err, ErrTenantNotFound) {
+		t.Errorf("Expected the tenant to be deleted, got %v", err)
+	}
+}
+
+func TestApp_Lifecycle_InvalidInstallJWT(t *testing.T) {
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Error generating RSA key: %s", err)
+	}
+
+	tests := map[string]*http.Request{
+		"wrong key":      signedRequest(t, http.MethodPost, "/installed", lifecyclePayload(EventInstalled), jwt.SigningMethodRS256, otherKey, jwt.MapClaims{"iss": testClientKey, "aud": testAppBaseURL}),
+		"shared secret":  signedRequest(t, http.MethodPost, "/installed", lifecyclePayload(EventInstalled), jwt.SigningMethodHS256, []byte(testSecret), jwt.MapClaims{"iss": testClientKey, "aud": testAppBaseURL}),
+		"wrong audience": installRequest(t, EventInstalled, jwt.MapClaims{"iss": testClientKey, "aud": "https://evil.example.com"}),
+		"wrong issuer":   installRequest(t, EventInstalled, jwt.MapClaims{"iss": "other-client", "aud": testAppBaseURL}),
+		"wrong qsh":      installRequest(t, EventInstalled, jwt.MapClaims{"iss": testClientKey, "aud": testAppBaseURL, "qsh": "invalid"}),
+		"expired":        installRequest(t, EventInstalled, jwt.MapClaims{"iss": testClientKey, "aud": testAppBaseURL, "exp": time.Now().Add(-time.Minute).Unix()}),
+	}
+	for name, req := range tests {
+		t.Run(name, func(t *testing.T) {
+			app := newTestApp(t)
+			if rec := serve(app.LifecycleHandler(), req); rec.Code != http.StatusUnauthorized {
+				t.Errorf("Expected status 401, got %d", rec.Code)
+			}
+			if _, err := app.Store.Get(context.Background(), testClientKey); !errors.Is(err, ErrTenantNotFound) {
+				t.Error("Expected no tenant to be stored")
+			}
+		})
+	}
+}
+
+func TestApp_Lifecycle_HandlerError(t *testing.T) {
+	app := newTestApp(t)
+	var logged error
+	app.ErrorLog = func(err error) { logged = err }
+	app.OnInstalled = func(ctx context.Context, tenant *Tenant) error {
+		return errors.New("database down")
+	}
+
+	rec := serve(app.LifecycleHandler(), installRequest(t, EventInstalled, jwt.MapClaims{"iss": testClientKey, "aud": testAppBaseURL}))
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("Expected status 500, got %d", rec.Code)
+	}
+	if logged == nil {
+		t.Error("Expected the error to be logged")
+	}
+}
+
+func TestApp_Middleware(t *testing.T) {
+	app := newTestApp(t)
+	app.Store.Save(context.Background(), &Tenant{ClientKey: testClientKey, SharedSecret: testSecret, Enabled: true})
+
+	var accountID string
+	h := app.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenant, _ := TenantFromContext(r.Context())
+		claims, _ := ClaimsFromContext(r.Context())
+		if tenant.ClientKey != testClientKey {
+			t.Errorf("Unexpected tenant: %+v", tenant)
+		}
+		accountID = claims.AccountID()
+	}))
+
+	req := signedRequest(t, http.MethodGet, "/issue-glance?issueKey=EX-1&lic=active", "", jwt.SigningMethodHS256, []byte(testSecret), jwt.MapClaims{"iss": testClientKey, "sub": "account-1"})
+	if rec := serve(h, req); rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body)
+	}
+	if accountID != "account-1" {
+		t.Errorf("Expected account ID account-1, got %q", accountID)
+	}
+
+	// the token of the jwt query parameter
+	req = signedRequest(t, http.MethodGet, "/issue-glance?issueKey=EX-1", "", jwt.SigningMethodHS256, []byte(testSecret), jwt.MapClaims{"iss": testClientKey})
+	token := strings.TrimPrefix(req.Header.Get("Authorization"), "JWT ")
+	req = httptest.NewRequest(http.MethodGet, "/connect/issue-glance?issueKey=EX-1&jwt="+token, nil)
+	if rec := serve(h, req); rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200, got %d: %s", rec.Code, rec.Body)
+	}
+}
+
+func TestApp_Middleware_Invalid(t *testing.T) {
+	app := newTestApp(t)
+	app.Store.Save(context.Background(), &Tenant{ClientKey: testClientKey, SharedSecret: testSecret, Enabled: true})
+	h := app.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Unexpected call of the handler")
+	}))
+
+	tampered := signedRequest(t, http.MethodGet, "/issue-glance?issueKey=EX-1", "", jwt.SigningMethodHS256, []byte(testSecret), jwt.MapClaims{"iss": testClientKey})
+	tampered.URL.RawQuery = "issueKey=EX-2"
+
+	tests := map[string]*http.Request{
+		"missing JWT":    httptest.NewRequest(http.MethodGet, "/connect/issue-glance", nil),
+		"unknown tenant": signedRequest(t, http.MethodGet, "/issue-glance", "", jwt.SigningMethodHS256, []byte(testSecret), jwt.MapClaims{"iss": "other-client"}),
+		"wrong secret":   signedRequest(t, http.MethodGet, "/issue-glance", "", jwt.SigningMethodHS256, []byte("other-secret"), jwt.MapClaims{"iss": testClientKey}),
+		"tampered query": tampered,
+		"context qsh":    signedRequest(t, http.MethodGet, "/issue-glance", "", jwt.SigningMethodHS256, []byte(testSecret), jwt.MapClaims{"iss": testClientKey, "qsh": ContextQSH}),
+	}
+	for name, req := range tests {
+		t.Run(name, func(t *testing.T) {
+			if rec := serve(h, req); rec.Code != http.StatusUnauthorized {
+				t.Errorf("Expected status 401, got %d", rec.Code)
+			}
+		})
+	}
+}
+
+func TestApp_Middleware_DisabledTenant(t *testing.T) {
+	app := newTestApp(t)
+	app.Store.Save(context.Background(), &Tenant{ClientKey: testClientKey, SharedSecret: testSecret, Enabled: false})
+	h := app.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Unexpected call of the handler")
+	}))
+
+	req := signedRequest(t, http.MethodGet, "/issue-glance?issueKey=EX-1", "", jwt.SigningMethodHS256, []byte(testSecret), jwt.MapClaims{"iss": testClientKey})
+	if _, _, err := app.VerifyRequest(req); !errors.Is(err, ErrTenantDisabled) {
+		t.Errorf("Expected ErrTenantDisabled, got %v", err)
+	}
+	if rec := serve(h, req); rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status 401, got %d", rec.Code)
+	}
+}
+
+func TestApp_Middleware_ContextQSH(t *testing.T) {
+	app := newTestApp(t)
+	app.AllowContextQSH = true
+	app.Store.Save(context.Background(), &Tenant{ClientKey: testClientKey, SharedSecret: testSecret, Enabled: true})
+
+	h := app.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	req := signedRequest(t, http.MethodPost, "/api/settings", "{}", jwt.SigningMethodHS256, []byte(testSecret), jwt.MapClaims{"iss": testClientKey, "qsh": ContextQSH})
+	if rec := serve(h, req); rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200, got %d: %s", rec.Code, rec.Body)
+	}
+}
+
+func TestApp_Client(t *testing.T) {
+	app := newTestApp(t)
+	client, err := app.Client(&Tenant{ClientKey: testClientKey, SharedSecret: testSecret, BaseURL: "https://example.atlassian.net"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got := client.BaseURL.String(); got != "https://example.atlassian.net/" {
+		t.Errorf("Unexpected base URL %s", got)
+	}
+}
@@ -0,0 +1,223 @@
+package connect
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/andygrunwald/go-jira/v2/internal/core"
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+// ContextQSH is the qsh claim of JWTs that are not bound to a request.
+const ContextQSH = "context-qsh"
+
+// Claims are the claims of a JWT sent by Jira.
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/understanding-jwt-for-connect-apps/
+type Claims struct {
+	jwt.RegisteredClaims
+
+	// QSH is the query string hash of the request.
+	QSH string `json:"qsh"`
+
+	// Context is the context claim, e.g. {"user":{"accountId":"..."}}.
+	Context json.RawMessage `json:"context,omitempty"`
+}
+
+// AccountID returns the account ID of the user the request was made for,
+// or an empty string for requests of the instance.
+func (c *Claims) AccountID() string {
+	return c.Subject
+}
+
+type contextKey int
+
+const (
+	tenantContextKey contextKey = iota
+	claimsContextKey
+)
+
+// TenantFromContext returns the tenant of a request verified by App.Middleware.
+func TenantFromContext(ctx context.Context) (*Tenant, bool) {
+	tenant, ok := ctx.Value(tenantContextKey).(*Tenant)
+	return tenant, ok
+}
+
+// ClaimsFromContext returns the claims of a request verified by App.Middleware.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsContextKey).(*Claims)
+	return claims, ok
+}
+
+// Middleware verifies the JWT of every request, sent as "Authorization: JWT <token>"
+// header or jwt query parameter, with the shared secret of its tenant.
+// The query string hash is verified against the request.
+// Requests with a missing or invalid JWT are answered with 401 Unauthorized.
+//
+// The tenant and the claims are available to next via TenantFromContext and ClaimsFromContext.
+func (a *App) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenant, claims, err := a.VerifyRequest(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), tenantContextKey, tenant)
+		ctx = context.WithValue(ctx, claimsContextKey, claims)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// VerifyRequest verifies the HS256 JWT of a request sent by Jira
+// and returns the tenant (the issuer) and the claims.
+// Requests of a disabled tenant are rejected with ErrTenantDisabled.
+func (a *App) VerifyRequest(r *http.Request) (*Tenant, *Claims, error) {
+	tenant, claims, err := a.verifyRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !tenant.Enabled {
+		return nil, nil, ErrTenantDisabled
+	}
+	return tenant, claims, nil
+}
+
+// verifyRequest verifies the HS256 JWT of a request regardless of whether the tenant is enabled,
+// as the enabled callback is sent by a disabled tenant.
+func (a *App) verifyRequest(r *http.Request) (*Tenant, *Claims, error) {
+	tokenString := tokenFromRequest(r)
+	if tokenString == "" {
+		return nil, nil, errors.New("connect: missing JWT")
+	}
+
+	var tenant *Tenant
+	claims := new(Claims)
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		issuer := token.Claims.(*Claims).Issuer
+		if issuer == "" {
+			return nil, errors.New("missing issuer")
+		}
+		var err error
+		tenant, err = a.tenantStore().Get(r.Context(), issuer)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(tenant.SharedSecret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		return nil, nil, fmt.Errorf("connect: invalid JWT: %w", err)
+	}
+
+	if !(a.AllowContextQSH && claims.QSH == ContextQSH) {
+		if err := a.verifyQSH(r, claims.QSH); err != nil {
+			return nil, nil, err
+		}
+	}
+	return tenant, claims, nil
+}
+
+// verifyInstallRequest verifies the RS256 JWT of an installed or uninstalled callback.
+// The token is signed by Atlassian with the key identified by its kid header.
+func (a *App) verifyInstallRequest(ctx context.Context, r *http.Request, clientKey string) error {
+	tokenString := tokenFromRequest(r)
+	if tokenString == "" {
+		return errors.New("connect: missing JWT")
+	}
+
+	claims := new(Claims)
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			return nil, errors.New("missing kid header")
+		}
+		return a.installPublicKey(ctx, kid)
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}))
+	if err != nil {
+		return fmt.Errorf("connect: invalid install JWT: %w", err)
+	}
+
+	if !claims.VerifyAudience(trimBaseURL(a.BaseURL), true) {
+		return fmt.Errorf("connect: install JWT audience %v doesn't match the app base URL", claims.Audience)
+	}
+	if claims.Issuer != clientKey {
+		return fmt.Errorf("connect: install JWT issuer %q doesn't match client key %q", claims.Issuer, clientKey)
+	}
+	return a.verifyQSH(r, claims.QSH)
+}
+
+// installPublicKey returns the public key with the key ID from the key server.
+// Keys never change, so they are cached.
+func (a *App) installPublicKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	a.mu.Lock()
+	key, ok := a.publicKey[kid]
+	a.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+
+	keyServerURL := a.KeyServerURL
+	if keyServerURL == "" {
+		keyServerURL = DefaultKeyServerURL
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, trimBaseURL(keyServerURL)+"/"+url.PathEscape(kid), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpClient := a.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching public key %s: %w", kid, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching public key %s: %s", kid, resp.Status)
+	}
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxPayloadSize))
+	if err != nil {
+		return nil, fmt.Errorf("fetching public key %s: %w", kid, err)
+	}
+	key, err = jwt.ParseRSAPublicKeyFromPEM(b)
+	if err != nil {
+		return nil, fmt.Errorf("parsing public key %s: %w", kid, err)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.publicKey == nil {
+		a.publicKey = make(map[string]*rsa.PublicKey)
+	}
+	a.publicKey[kid] = key
+	return key, nil
+}
+
+// verifyQSH compares the query string hash of r with qsh.
+// The path of r is taken relative to the path of the app base URL.
+func (a *App) verifyQSH(r *http.Request, qsh string) error {
+	u := *r.URL
+	if base, err := url.Parse(a.BaseURL); err == nil {
+		u.Path = strings.TrimPrefix(u.Path, strings.TrimSuffix(base.Path, "/"))
+	}
+	if core.QueryStringHash(r.Method, &u) != qsh {
+		return errors.New("connect: query string hash doesn't match the request")
+	}
+	return nil
+}
+
+// tokenFromRequest returns the JWT of the Authorization header or jwt query parameter.
+func tokenFromRequest(r *http.Request) string {
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "JWT ") {
+		return strings.TrimPrefix(auth, "JWT ")
+	}
+	return r.URL.Query().Get("jwt")
+}
@@ -0,0 +1,79 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrTenantNotFound is returned by a TenantStore if there is no tenant with the client key.
+var ErrTenantNotFound = errors.New("connect: tenant not found")
+
+// ErrTenantDisabled is returned for requests of a tenant that disabled the app.
+var ErrTenantDisabled = errors.New("connect: tenant disabled")
+
+// Tenant is a Jira Cloud site the app is installed on.
+type Tenant struct {
+	// ClientKey identifies the site. It is the issuer of all JWTs of the site.
+	ClientKey string `json:"clientKey"`
+
+	// SharedSecret signs the JWTs of the site and the requests of the app to the site.
+	SharedSecret string `json:"sharedSecret"`
+
+	BaseURL       string `json:"baseUrl"`
+	DisplayURL    string `json:"displayUrl,omitempty"`
+	CloudID       string `json:"cloudId,omitempty"`
+	OAuthClientID string `json:"oauthClientId,omitempty"`
+	Enabled       bool   `json:"enabled"`
+}
+
+// TenantStore persists the tenants of an app.
+// Implementations must be safe for concurrent use.
+type TenantStore interface {
+	// Get returns the tenant with the client key or ErrTenantNotFound.
+	Get(ctx context.Context, clientKey string) (*Tenant, error)
+	// Save creates or replaces the tenant.
+	Save(ctx context.Context, tenant *Tenant) error
+	// Delete removes the tenant with the client key.
+	Delete(ctx context.Context, clientKey string) error
+}
+
+// MemoryTenantStore is a TenantStore keeping the tenants in memory.
+// It is meant for development and tests, as the tenants are lost on restart.
+type MemoryTenantStore struct {
+	mu      sync.RWMutex
+	tenants map[string]Tenant
+}
+
+// Get returns the tenant with the client key or ErrTenantNotFound.
+func (s *MemoryTenantStore) Get(ctx context.Context, clientKey string) (*Tenant, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tenant, ok := s.tenants[clientKey]
+	if !ok {
+		return nil, ErrTenantNotFound
+	}
+	return &tenant, nil
+}
+
+// Save creates or replaces the tenant.
+func (s *MemoryTenantStore) Save(ctx context.Context, tenant *Tenant) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.tenants == nil {
+		s.tenants = make(map[string]Tenant)
+	}
+	s.tenants[tenant.ClientKey] = *tenant
+	return nil
+}
+
+// Delete removes the tenant with the client key.
+func (s *MemoryTenantStore) Delete(ctx context.Context, clientKey string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.tenants, clientKey)
+	return nil
+}
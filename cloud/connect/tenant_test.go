@@ -0,0 +1,38 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestMemoryTenantStore(t *testing.T) {
+	ctx := context.Background()
+	store := &MemoryTenantStore{}
+
+	if _, err := store.Get(ctx, "client"); !errors.Is(err, ErrTenantNotFound) {
+		t.Errorf("Expected ErrTenantNotFound, got %v", err)
+	}
+
+	tenant := &Tenant{ClientKey: "client", SharedSecret: "secret"}
+	if err := store.Save(ctx, tenant); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	// the store keeps a copy
+	tenant.SharedSecret = "changed"
+
+	got, err := store.Get(ctx, "client")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got.SharedSecret != "secret" {
+		t.Errorf("Expected shared secret %q, got %q", "secret", got.SharedSecret)
+	}
+
+	if err := store.Delete(ctx, "client"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if _, err := store.Get(ctx, "client"); !errors.Is(err, ErrTenantNotFound) {
+		t.Errorf("Expected ErrTenantNotFound after Delete, got %v", err)
+	}
+}
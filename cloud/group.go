@@ -5,6 +5,10 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/andygrunwald/go-jira/v2/internal/core"
 )
 
 // GroupService handles Groups for the Jira instance / API.
@@ -18,15 +22,21 @@ type groupMembersResult struct {
 	StartAt    int           `json:"startAt"`
 	MaxResults int           `json:"maxResults"`
 	Total      int           `json:"total"`
+	IsLast     bool          `json:"isLast"`
 	Members    []GroupMember `json:"values"`
 }
 
+func (r *groupMembersResult) Page() core.Page {
+	return core.Page{StartAt: r.StartAt, MaxResults: r.MaxResults, Total: r.Total, IsLast: r.IsLast}
+}
+
 // Group represents a Jira group
 type Group struct {
-	Name   string       `json:"name,omitempty" structs:"name,omitempty"`
-	Self   string       `json:"self,omitempty" structs:"self,omitempty"`
-	Users  GroupMembers `json:"users,omitempty" structs:"users,omitempty"`
-	Expand string       `json:"expand,omitempty" structs:"expand,omitempty"`
+	Name    string       `json:"name,omitempty" structs:"name,omitempty"`
+	GroupID string       `json:"groupId,omitempty" structs:"groupId,omitempty"`
+	Self    string       `json:"self,omitempty" structs:"self,omitempty"`
+	Users   GroupMembers `json:"users,omitempty" structs:"users,omitempty"`
+	Expand  string       `json:"expand,omitempty" structs:"expand,omitempty"`
 }
 
 // GroupMembers represent members in a Jira group
@@ -58,6 +68,64 @@ type GroupSearchOptions struct {
 	IncludeInactiveUsers bool
 }
 
+// GroupDeleteOptions specifies the optional parameters of GroupService.Delete and GroupService.DeleteByID.
+type GroupDeleteOptions struct {
+	// SwapGroup is the name of the group that takes over the permissions and restrictions of the deleted group.
+	SwapGroup string `url:"swapGroup,omitempty"`
+	// SwapGroupID is the ID of the group that takes over the permissions and restrictions of the deleted group.
+	// It can be used instead of SwapGroup.
+	SwapGroupID string `url:"swapGroupId,omitempty"`
+}
+
+// GroupFindOptions specifies the optional parameters of GroupService.Find.
+type GroupFindOptions struct {
+	// Exclude are the names of groups left out of the result.
+	Exclude []string `url:"exclude,omitempty"`
+	// ExcludeID are the IDs of groups left out of the result.
+	ExcludeID       []string `url:"excludeId,omitempty"`
+	MaxResults      int      `url:"maxResults,omitempty"`
+	CaseInsensitive bool     `url:"caseInsensitive,omitempty"`
+}
+
+// GroupSuggestion is a group found by GroupService.Find.
+type GroupSuggestion struct {
+	Name    string `json:"name"`
+	GroupID string `json:"groupId,omitempty"`
+	// HTML is the name with the matching part highlighted, e.g. "<b>jira</b>-users".
+	HTML string `json:"html,omitempty"`
+}
+
+// GroupBulkOptions specifies the parameters of GroupService.BulkGet.
+// All groups are returned if neither GroupIDs nor GroupNames are set.
+type GroupBulkOptions struct {
+	StartAt    int      `url:"startAt,omitempty"`
+	MaxResults int      `url:"maxResults,omitempty"`
+	GroupIDs   []string `url:"groupId,omitempty"`
+	GroupNames []string `url:"groupName,omitempty"`
+}
+
+// groupBulkResult is only a small wrapper around the BulkGet method
+// to be able to parse the results
+type groupBulkResult struct {
+	StartAt    int     `json:"startAt"`
+	MaxResults int     `json:"maxResults"`
+	Total      int     `json:"total"`
+	IsLast     bool    `json:"isLast"`
+	Values     []Group `json:"values"`
+}
+
+func (r *groupBulkResult) Page() core.Page {
+	return core.Page{StartAt: r.StartAt, MaxResults: r.MaxResults, Total: r.Total, IsLast: r.IsLast}
+}
+
+// GroupSyncResult is the result of GroupService.SyncMembers and GroupService.SyncMembersByGroupID.
+type GroupSyncResult struct {
+	// Added are the account IDs of the users added to the group.
+	Added []string
+	// Removed are the account IDs of the users removed from the group.
+	Removed []string
+}
+
 // Get returns a paginated list of members of the specified group and its subgroups.
 // Users in the page are ordered by user names.
 // User of this resource is required to have sysadmin or admin permissions.
@@ -102,6 +170,14 @@ func (s *GroupService) Get(ctx context.Context, name string, options *GroupSearc
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-groups/#api-rest-api-3-group-user-post
 func (s *GroupService) AddUserByGroupName(ctx context.Context, groupName string, accountID string) (*Group, *Response, error) {
+	if r := s.client.UserResolver; r != nil {
+		resolved, err := r.AccountID(ctx, accountID)
+		if err != nil {
+			return nil, nil, err
+		}
+		accountID = resolved
+	}
+
 	apiEndpoint := fmt.Sprintf("/rest/api/3/group/user?groupname=%s", groupName)
 	var user struct {
 		AccountID string `json:"accountId"`
@@ -130,7 +206,15 @@ func (s *GroupService) AddUserByGroupName(ctx context.Context, groupName string,
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-groups/#api-rest-api-3-group-user-delete
 // Caller must close resp.Body
 func (s *GroupService) RemoveUserByGroupName(ctx context.Context, groupName string, accountID string) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("/rest/api/3/group/user?groupname=%s&accountId=%s", groupName, accountID)
+	if r := s.client.UserResolver; r != nil {
+		resolved, err := r.AccountID(ctx, accountID)
+		if err != nil {
+			return nil, err
+		}
+		accountID = resolved
+	}
+
+	apiEndpoint := fmt.Sprintf("/rest/api/3/group/user?groupname=%s&accountId=%s", groupName, url.QueryEscape(accountID))
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
 	if err != nil {
 		return nil, err
@@ -144,3 +228,302 @@ func (s *GroupService) RemoveUserByGroupName(ctx context.Context, groupName stri
 
 	return resp, nil
 }
+
+// Create creates a group.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-groups/#api-rest-api-2-group-post
+func (s *GroupService) Create(ctx context.Context, name string) (*Group, *Response, error) {
+	apiEndpoint := "rest/api/2/group"
+	body := struct {
+		Name string `json:"name"`
+	}{name}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	group := new(Group)
+	resp, err := s.client.Do(req, group)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return group, resp, nil
+}
+
+// Delete deletes the group with the given name.
+// As group names can change, DeleteByID should be preferred.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-groups/#api-rest-api-2-group-delete
+func (s *GroupService) Delete(ctx context.Context, groupName string, options *GroupDeleteOptions) (*Response, error) {
+	return s.delete(ctx, groupName, "", options)
+}
+
+// DeleteByID deletes the group with the given ID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-groups/#api-rest-api-2-group-delete
+func (s *GroupService) DeleteByID(ctx context.Context, groupID string, options *GroupDeleteOptions) (*Response, error) {
+	return s.delete(ctx, "", groupID, options)
+}
+
+func (s *GroupService) delete(ctx context.Context, groupName, groupID string, options *GroupDeleteOptions) (*Response, error) {
+	if options == nil {
+		options = &GroupDeleteOptions{}
+	}
+	params := struct {
+		GroupName string `url:"groupname,omitempty"`
+		GroupID   string `url:"groupId,omitempty"`
+		*GroupDeleteOptions
+	}{groupName, groupID, options}
+	apiEndpoint, err := addOptions("rest/api/2/group", params)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// GetByID returns a page of the members of the group with the given ID.
+// The paging values are set in the Response.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-groups/#api-rest-api-2-group-member-get
+func (s *GroupService) GetByID(ctx context.Context, groupID string, options *GroupSearchOptions) ([]GroupMember, *Response, error) {
+	params := url.Values{"groupId": {groupID}}
+	if options != nil {
+		params.Set("startAt", strconv.Itoa(options.StartAt))
+		params.Set("maxResults", strconv.Itoa(options.MaxResults))
+		params.Set("includeInactiveUsers", strconv.FormatBool(options.IncludeInactiveUsers))
+	}
+	apiEndpoint := "rest/api/2/group/member?" + params.Encode()
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	group := new(groupMembersResult)
+	resp, err := s.client.Do(req, group)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return group.Members, resp, nil
+}
+
+// Find returns the groups matching query, as shown in the group picker.
+// The number of matching groups is set as Response.Total.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-groups/#api-rest-api-2-groups-picker-get
+func (s *GroupService) Find(ctx context.Context, query string, options *GroupFindOptions) ([]GroupSuggestion, *Response, error) {
+	if options == nil {
+		options = &GroupFindOptions{}
+	}
+	params := struct {
+		Query string `url:"query,omitempty"`
+		*GroupFindOptions
+	}{query, options}
+	apiEndpoint, err := addOptions("rest/api/2/groups/picker", params)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := struct {
+		Total  int               `json:"total"`
+		Groups []GroupSuggestion `json:"groups"`
+	}{}
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	resp.Total = result.Total
+	return result.Groups, resp, nil
+}
+
+// BulkGet returns a page of the groups with the given IDs or names.
+// The paging values are set in the Response.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-groups/#api-rest-api-2-group-bulk-get
+func (s *GroupService) BulkGet(ctx context.Context, options *GroupBulkOptions) ([]Group, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/group/bulk", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(groupBulkResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Values, resp, nil
+}
+
+// AddUserByGroupID adds a user to the group with the given ID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-groups/#api-rest-api-2-group-user-post
+func (s *GroupService) AddUserByGroupID(ctx context.Context, groupID string, accountID string) (*Group, *Response, error) {
+	if r := s.client.UserResolver; r != nil {
+		resolved, err := r.AccountID(ctx, accountID)
+		if err != nil {
+			return nil, nil, err
+		}
+		accountID = resolved
+	}
+
+	apiEndpoint := "rest/api/2/group/user?" + url.Values{"groupId": {groupID}}.Encode()
+	body := struct {
+		AccountID string `json:"accountId"`
+	}{accountID}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	group := new(Group)
+	resp, err := s.client.Do(req, group)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return group, resp, nil
+}
+
+// RemoveUserByGroupID removes a user from the group with the given ID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-groups/#api-rest-api-2-group-user-delete
+func (s *GroupService) RemoveUserByGroupID(ctx context.Context, groupID string, accountID string) (*Response, error) {
+	if r := s.client.UserResolver; r != nil {
+		resolved, err := r.AccountID(ctx, accountID)
+		if err != nil {
+			return nil, err
+		}
+		accountID = resolved
+	}
+
+	apiEndpoint := "rest/api/2/group/user?" + url.Values{"groupId": {groupID}, "accountId": {accountID}}.Encode()
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// SyncMembers makes accountIDs the members of the group with the given name.
+// Users missing in the group are added, members not in accountIDs are removed, inactive ones included.
+// If a Client.UserResolver is set, accountIDs may also contain usernames and email addresses.
+// As group names can change, SyncMembersByGroupID should be preferred.
+//
+// The changes are applied one by one. If one of them fails, the changes applied so far are returned with the error.
+func (s *GroupService) SyncMembers(ctx context.Context, groupName string, accountIDs []string) (*GroupSyncResult, error) {
+	return s.syncMembers(ctx, groupName, accountIDs, groupMembership{
+		get: func(options *GroupSearchOptions) ([]GroupMember, *Response, error) {
+			return s.Get(ctx, groupName, options)
+		},
+		add: func(accountID string) error {
+			_, _, err := s.AddUserByGroupName(ctx, url.QueryEscape(groupName), accountID)
+			return err
+		},
+		remove: func(accountID string) error {
+			_, err := s.RemoveUserByGroupName(ctx, url.QueryEscape(groupName), accountID)
+			return err
+		},
+	})
+}
+
+// SyncMembersByGroupID makes accountIDs the members of the group with the given ID.
+// It works like SyncMembers, but keeps working if the group is renamed.
+func (s *GroupService) SyncMembersByGroupID(ctx context.Context, groupID string, accountIDs []string) (*GroupSyncResult, error) {
+	return s.syncMembers(ctx, groupID, accountIDs, groupMembership{
+		get: func(options *GroupSearchOptions) ([]GroupMember, *Response, error) {
+			return s.GetByID(ctx, groupID, options)
+		},
+		add: func(accountID string) error {
+			_, _, err := s.AddUserByGroupID(ctx, groupID, accountID)
+			return err
+		},
+		remove: func(accountID string) error {
+			_, err := s.RemoveUserByGroupID(ctx, groupID, accountID)
+			return err
+		},
+	})
+}
+
+// groupMembership reads and changes the members of a group identified by name or ID.
+type groupMembership struct {
+	get    func(options *GroupSearchOptions) ([]GroupMember, *Response, error)
+	add    func(accountID string) error
+	remove func(accountID string) error
+}
+
+// syncMembers implements SyncMembers and SyncMembersByGroupID, group is only used in errors.
+func (s *GroupService) syncMembers(ctx context.Context, group string, accountIDs []string, m groupMembership) (*GroupSyncResult, error) {
+	current := make(map[string]bool)
+	options := &GroupSearchOptions{MaxResults: 50, IncludeInactiveUsers: true}
+	for {
+		members, resp, err := m.get(options)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			current[member.AccountID] = true
+		}
+		options.StartAt += len(members)
+		if resp.IsLast || len(members) == 0 || options.StartAt >= resp.Total {
+			break
+		}
+	}
+
+	result := &GroupSyncResult{}
+	desired := make(map[string]bool, len(accountIDs))
+	for _, id := range accountIDs {
+		if r := s.client.UserResolver; r != nil {
+			resolved, err := r.AccountID(ctx, id)
+			if err != nil {
+				return result, err
+			}
+			id = resolved
+		}
+		if desired[id] {
+			continue
+		}
+		desired[id] = true
+		if current[id] {
+			continue
+		}
+		if err := m.add(id); err != nil {
+			return result, fmt.Errorf("adding %s to group %s: %w", id, group, err)
+		}
+		result.Added = append(result.Added, id)
+	}
+
+	var removed []string
+	for id := range current {
+		if !desired[id] {
+			removed = append(removed, id)
+		}
+	}
+	sort.Strings(removed)
+	for _, id := range removed {
+		if err := m.remove(id); err != nil {
+			return result, fmt.Errorf("removing %s from group %s: %w", id, group, err)
+		}
+		result.Removed = append(result.Removed, id)
+	}
+	return result, nil
+}
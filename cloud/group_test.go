@@ -2,6 +2,7 @@ package cloud
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -95,3 +96,228 @@ func TestGroupService_Remove(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestGroupService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, "/rest/api/2/group")
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"jira-testers","groupId":"276f955c-63d7-42c8-9520-92d01dca0625","self":"http://www.example.com/jira/rest/api/2/group?groupId=276f955c-63d7-42c8-9520-92d01dca0625"}`)
+	})
+
+	group, _, err := testClient.Group.Create(context.Background(), "jira-testers")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if group.Name != "jira-testers" || group.GroupID != "276f955c-63d7-42c8-9520-92d01dca0625" {
+		t.Errorf("Unexpected group: %+v", group)
+	}
+}
+
+func TestGroupService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestParams(t, r, map[string]string{"groupname": "jira testers", "swapGroup": "jira-users"})
+	})
+
+	if _, err := testClient.Group.Delete(context.Background(), "jira testers", &GroupDeleteOptions{SwapGroup: "jira-users"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestGroupService_DeleteByID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestParams(t, r, map[string]string{"groupId": "276f955c", "swapGroupId": "5a8f4b5c"})
+	})
+
+	if _, err := testClient.Group.DeleteByID(context.Background(), "276f955c", &GroupDeleteOptions{SwapGroupID: "5a8f4b5c"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestGroupService_GetByID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group/member", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"groupId": "276f955c", "startAt": "0", "maxResults": "50", "includeInactiveUsers": "true"})
+		fmt.Fprint(w, `{"maxResults":50,"startAt":0,"total":1,"isLast":true,"values":[{"accountId":"5b10a2844c20165700ede21g","displayName":"Mia Krystof","active":true}]}`)
+	})
+
+	members, resp, err := testClient.Group.GetByID(context.Background(), "276f955c", &GroupSearchOptions{MaxResults: 50, IncludeInactiveUsers: true})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(members) != 1 || members[0].AccountID != "5b10a2844c20165700ede21g" {
+		t.Errorf("Unexpected members: %+v", members)
+	}
+	if !resp.IsLast || resp.Total != 1 {
+		t.Errorf("Unexpected paging values: %+v", resp)
+	}
+}
+
+func TestGroupService_Find(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/groups/picker", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"query": "jira", "exclude": "jira-administrators", "maxResults": "10"})
+		fmt.Fprint(w, `{"header":"Showing 2 of 2 matching groups","total":2,"groups":[
+			{"name":"jira-software-users","html":"<b>jira</b>-software-users","groupId":"276f955c-63d7-42c8-9520-92d01dca0625"},
+			{"name":"jira-testers","html":"<b>jira</b>-testers","groupId":"6e87dc72-4f1f-421f-9382-2fee8b652487"}]}`)
+	})
+
+	groups, resp, err := testClient.Group.Find(context.Background(), "jira", &GroupFindOptions{Exclude: []string{"jira-administrators"}, MaxResults: 10})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(groups) != 2 || groups[1].Name != "jira-testers" || groups[1].GroupID != "6e87dc72-4f1f-421f-9382-2fee8b652487" {
+		t.Errorf("Unexpected groups: %+v", groups)
+	}
+	if resp.Total != 2 {
+		t.Errorf("Expected Total 2, got %d", resp.Total)
+	}
+}
+
+func TestGroupService_BulkGet(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group/bulk", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got := r.URL.Query()["groupId"]; len(got) != 2 || got[0] != "276f955c" || got[1] != "6e87dc72" {
+			t.Errorf("Unexpected groupId parameters: %v", got)
+		}
+		fmt.Fprint(w, `{"maxResults":10,"startAt":0,"total":2,"isLast":true,"values":[
+			{"name":"jira-software-users","groupId":"276f955c"},
+			{"name":"jira-testers","groupId":"6e87dc72"}]}`)
+	})
+
+	groups, resp, err := testClient.Group.BulkGet(context.Background(), &GroupBulkOptions{GroupIDs: []string{"276f955c", "6e87dc72"}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(groups) != 2 || groups[0].Name != "jira-software-users" {
+		t.Errorf("Unexpected groups: %+v", groups)
+	}
+	if !resp.IsLast || resp.Total != 2 {
+		t.Errorf("Unexpected paging values: %+v", resp)
+	}
+}
+
+func TestGroupService_AddUserByGroupID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestParams(t, r, map[string]string{"groupId": "276f955c"})
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"jira-testers","groupId":"276f955c"}`)
+	})
+
+	if group, _, err := testClient.Group.AddUserByGroupID(context.Background(), "276f955c", "5b10ac8d82e05b22cc7d4ef5"); err != nil {
+		t.Errorf("Error given: %s", err)
+	} else if group.GroupID != "276f955c" {
+		t.Errorf("Unexpected group: %+v", group)
+	}
+}
+
+func TestGroupService_RemoveUserByGroupID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestParams(t, r, map[string]string{"groupId": "276f955c", "accountId": "5b10ac8d82e05b22cc7d4ef5"})
+	})
+
+	if _, err := testClient.Group.RemoveUserByGroupID(context.Background(), "276f955c", "5b10ac8d82e05b22cc7d4ef5"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestGroupService_SyncMembers(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group/member", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("startAt") == "0" {
+			fmt.Fprint(w, `{"maxResults":2,"startAt":0,"total":3,"isLast":false,"values":[{"accountId":"a"},{"accountId":"b"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"maxResults":2,"startAt":2,"total":3,"isLast":true,"values":[{"accountId":"c"}]}`)
+	})
+	var added, removed []string
+	testMux.HandleFunc("/rest/api/3/group/user", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("groupname") != "idp users" {
+			t.Errorf("Unexpected group name %q", r.URL.Query().Get("groupname"))
+		}
+		switch r.Method {
+		case http.MethodPost:
+			var user struct {
+				AccountID string `json:"accountId"`
+			}
+			json.NewDecoder(r.Body).Decode(&user)
+			added = append(added, user.AccountID)
+			fmt.Fprint(w, `{"name":"idp users"}`)
+		case http.MethodDelete:
+			removed = append(removed, r.URL.Query().Get("accountId"))
+		}
+	})
+
+	result, err := testClient.Group.SyncMembers(context.Background(), "idp users", []string{"b", "d", "d", "e"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if fmt.Sprint(result.Added) != "[d e]" || fmt.Sprint(result.Removed) != "[a c]" {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+	if fmt.Sprint(added) != "[d e]" || fmt.Sprint(removed) != "[a c]" {
+		t.Errorf("Unexpected requests: added %v, removed %v", added, removed)
+	}
+}
+
+func TestGroupService_SyncMembersByGroupID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group/member", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"groupId": "276f955c", "startAt": "0", "maxResults": "50", "includeInactiveUsers": "true"})
+		fmt.Fprint(w, `{"maxResults":50,"startAt":0,"total":2,"isLast":true,"values":[{"accountId":"a"},{"accountId":"b"}]}`)
+	})
+	var added, removed []string
+	testMux.HandleFunc("/rest/api/2/group/user", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("groupId") != "276f955c" {
+			t.Errorf("Unexpected group ID %q", r.URL.Query().Get("groupId"))
+		}
+		switch r.Method {
+		case http.MethodPost:
+			var user struct {
+				AccountID string `json:"accountId"`
+			}
+			json.NewDecoder(r.Body).Decode(&user)
+			added = append(added, user.AccountID)
+			fmt.Fprint(w, `{"name":"idp users","groupId":"276f955c"}`)
+		case http.MethodDelete:
+			removed = append(removed, r.URL.Query().Get("accountId"))
+		}
+	})
+
+	result, err := testClient.Group.SyncMembersByGroupID(context.Background(), "276f955c", []string{"b", "c"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if fmt.Sprint(result.Added) != "[c]" || fmt.Sprint(result.Removed) != "[a]" {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+	if fmt.Sprint(added) != "[c]" || fmt.Sprint(removed) != "[a]" {
+		t.Errorf("Unexpected requests: added %v, removed %v", added, removed)
+	}
+}
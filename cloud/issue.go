@@ -14,7 +14,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/fatih/structs"
+	"github.com/andygrunwald/go-jira/v2/internal/core"
 	"github.com/google/go-querystring/query"
 	"github.com/trivago/tgo/tcontainer"
 )
@@ -113,6 +113,7 @@ type IssueFields struct {
 	Created                       Time              `json:"created,omitempty" structs:"created,omitempty"`
 	Duedate                       Date              `json:"duedate,omitempty" structs:"duedate,omitempty"`
 	Watches                       *Watches          `json:"watches,omitempty" structs:"watches,omitempty"`
+	Votes                         *Votes            `json:"votes,omitempty" structs:"votes,omitempty"`
 	Assignee                      *User             `json:"assignee,omitempty" structs:"assignee,omitempty"`
 	Updated                       Time              `json:"updated,omitempty" structs:"updated,omitempty"`
 	Description                   string            `json:"description,omitempty" structs:"description,omitempty"`
@@ -148,16 +149,7 @@ type IssueFields struct {
 // MarshalJSON is a custom JSON marshal function for the IssueFields structs.
 // It handles Jira custom fields and maps those from / to "Unknowns" key.
 func (i *IssueFields) MarshalJSON() ([]byte, error) {
-	m := structs.Map(i)
-	unknowns, okay := m["Unknowns"]
-	if okay {
-		// if unknowns present, shift all key value from unknown to a level up
-		for key, value := range unknowns.(tcontainer.MarshalMap) {
-			m[key] = value
-		}
-		delete(m, "Unknowns")
-	}
-	return json.Marshal(m)
+	return core.MarshalWithUnknowns(i)
 }
 
 // UnmarshalJSON is a custom JSON marshal function for the IssueFields structs.
@@ -176,37 +168,12 @@ func (i *IssueFields) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	totalMap := tcontainer.NewMarshalMap()
-	err := json.Unmarshal(data, &totalMap)
+	unknowns, err := core.UnknownFields(data, reflect.TypeOf(*i))
 	if err != nil {
 		return err
 	}
-
-	t := reflect.TypeOf(*i)
-	for i := 0; i < t.NumField(); i++ {
-		field := t.Field(i)
-		tagDetail := field.Tag.Get("json")
-		if tagDetail == "" {
-			// ignore if there are no tags
-			continue
-		}
-		options := strings.Split(tagDetail, ",")
-
-		if len(options) == 0 {
-			return fmt.Errorf("no tags options found for %s", field.Name)
-		}
-		// the first one is the json tag
-		key := options[0]
-		if _, okay := totalMap.Value(key); okay {
-			delete(totalMap, key)
-		}
-
-	}
-	i = (*IssueFields)(aux.Alias)
-	// all the tags found in the struct were removed. Whatever is left are unknowns to struct
-	i.Unknowns = totalMap
+	i.Unknowns = unknowns
 	return nil
-
 }
 
 // IssueRenderedFields represents rendered fields of a Jira issue.
@@ -247,6 +214,64 @@ type Watches struct {
 	Watchers   []*Watcher `json:"watchers,omitempty" structs:"watchers,omitempty"`
 }
 
+// Votes represents the votes of a Jira issue.
+type Votes struct {
+	Self     string `json:"self,omitempty" structs:"self,omitempty"`
+	Votes    int    `json:"votes,omitempty" structs:"votes,omitempty"`
+	HasVoted bool   `json:"hasVoted,omitempty" structs:"hasVoted,omitempty"`
+	Voters   []User `json:"voters,omitempty" structs:"voters,omitempty"`
+}
+
+// Notification represents an email notification about an issue.
+// At least one of TextBody and HTMLBody should be set.
+type Notification struct {
+	// Subject defaults to the issue key and summary.
+	Subject  string                   `json:"subject,omitempty"`
+	TextBody string                   `json:"textBody,omitempty"`
+	HTMLBody string                   `json:"htmlBody,omitempty"`
+	To       *NotificationRecipients  `json:"to,omitempty"`
+	Restrict *NotificationRestriction `json:"restrict,omitempty"`
+}
+
+// NotificationRecipients are the recipients of a Notification.
+type NotificationRecipients struct {
+	Reporter bool                `json:"reporter,omitempty"`
+	Assignee bool                `json:"assignee,omitempty"`
+	Watchers bool                `json:"watchers,omitempty"`
+	Voters   bool                `json:"voters,omitempty"`
+	Users    []NotificationUser  `json:"users,omitempty"`
+	Groups   []NotificationGroup `json:"groups,omitempty"`
+}
+
+// NotificationRestriction restricts the recipients of a Notification
+// to the members of the groups and the users having the permissions.
+type NotificationRestriction struct {
+	Groups      []NotificationGroup      `json:"groups,omitempty"`
+	Permissions []NotificationPermission `json:"permissions,omitempty"`
+}
+
+// NotificationUser is a user receiving a Notification.
+type NotificationUser struct {
+	AccountID string `json:"accountId"`
+	// Name and EmailAddress identify the user if AccountID is empty.
+	// They are not sent to Jira, but converted to the account ID by the Client.UserResolver.
+	Name         string `json:"-"`
+	EmailAddress string `json:"-"`
+}
+
+// NotificationGroup is a group receiving a Notification, identified by its name or group ID.
+type NotificationGroup struct {
+	Name    string `json:"name,omitempty"`
+	GroupID string `json:"groupId,omitempty"`
+}
+
+// NotificationPermission is a permission the recipients of a Notification must have,
+// identified by its ID or key, e.g. "BROWSE".
+type NotificationPermission struct {
+	ID  string `json:"id,omitempty"`
+	Key string `json:"key,omitempty"`
+}
+
 // Watcher represents a simplified user that "observes" the issue
 type Watcher struct {
 	Self        string `json:"self,omitempty" structs:"self,omitempty"`
@@ -355,12 +380,8 @@ type Option struct {
 // UnmarshalJSON will transform the Jira time into a time.Time
 // during the transformation of the Jira JSON response
 func (t *Time) UnmarshalJSON(b []byte) error {
-	// Ignore null, like in the main JSON package.
-	if string(b) == "null" {
-		return nil
-	}
-	ti, err := time.Parse("\"2006-01-02T15:04:05.999-0700\"", string(b))
-	if err != nil {
+	ti, ok, err := core.UnmarshalTime(b)
+	if err != nil || !ok {
 		return err
 	}
 	*t = Time(ti)
@@ -370,18 +391,14 @@ func (t *Time) UnmarshalJSON(b []byte) error {
 // MarshalJSON will transform the time.Time into a Jira time
 // during the creation of a Jira request
 func (t Time) MarshalJSON() ([]byte, error) {
-	return []byte(time.Time(t).Format("\"2006-01-02T15:04:05.000-0700\"")), nil
+	return core.MarshalTime(time.Time(t)), nil
 }
 
 // UnmarshalJSON will transform the Jira date into a time.Time
 // during the transformation of the Jira JSON response
 func (t *Date) UnmarshalJSON(b []byte) error {
-	// Ignore null, like in the main JSON package.
-	if string(b) == "null" {
-		return nil
-	}
-	ti, err := time.Parse("\"2006-01-02\"", string(b))
-	if err != nil {
+	ti, ok, err := core.UnmarshalDate(b)
+	if err != nil || !ok {
 		return err
 	}
 	*t = Date(ti)
@@ -392,8 +409,7 @@ func (t *Date) UnmarshalJSON(b []byte) error {
 // date string as Jira expects during the creation of a
 // Jira request
 func (t Date) MarshalJSON() ([]byte, error) {
-	time := time.Time(t)
-	return []byte(time.Format("\"2006-01-02\"")), nil
+	return core.MarshalDate(time.Time(t)), nil
 }
 
 // Worklog represents the work log of a Jira issue.
@@ -408,24 +424,22 @@ type Worklog struct {
 
 // WorklogRecord represents one entry of a Worklog
 type WorklogRecord struct {
-	Self             string           `json:"self,omitempty" structs:"self,omitempty"`
-	Author           *User            `json:"author,omitempty" structs:"author,omitempty"`
-	UpdateAuthor     *User            `json:"updateAuthor,omitempty" structs:"updateAuthor,omitempty"`
-	Comment          string           `json:"comment,omitempty" structs:"comment,omitempty"`
-	Created          *Time            `json:"created,omitempty" structs:"created,omitempty"`
-	Updated          *Time            `json:"updated,omitempty" structs:"updated,omitempty"`
-	Started          *Time            `json:"started,omitempty" structs:"started,omitempty"`
-	TimeSpent        string           `json:"timeSpent,omitempty" structs:"timeSpent,omitempty"`
-	TimeSpentSeconds int              `json:"timeSpentSeconds,omitempty" structs:"timeSpentSeconds,omitempty"`
-	ID               string           `json:"id,omitempty" structs:"id,omitempty"`
-	IssueID          string           `json:"issueId,omitempty" structs:"issueId,omitempty"`
-	Properties       []EntityProperty `json:"properties,omitempty"`
-}
-
-type EntityProperty struct {
-	Key   string      `json:"key"`
-	Value interface{} `json:"value"`
-}
+	Self             string             `json:"self,omitempty" structs:"self,omitempty"`
+	Author           *User              `json:"author,omitempty" structs:"author,omitempty"`
+	UpdateAuthor     *User              `json:"updateAuthor,omitempty" structs:"updateAuthor,omitempty"`
+	Comment          string             `json:"comment,omitempty" structs:"comment,omitempty"`
+	Created          *Time              `json:"created,omitempty" structs:"created,omitempty"`
+	Updated          *Time              `json:"updated,omitempty" structs:"updated,omitempty"`
+	Started          *Time              `json:"started,omitempty" structs:"started,omitempty"`
+	TimeSpent        string             `json:"timeSpent,omitempty" structs:"timeSpent,omitempty"`
+	TimeSpentSeconds int                `json:"timeSpentSeconds,omitempty" structs:"timeSpentSeconds,omitempty"`
+	ID               string             `json:"id,omitempty" structs:"id,omitempty"`
+	IssueID          string             `json:"issueId,omitempty" structs:"issueId,omitempty"`
+	Visibility       *CommentVisibility `json:"visibility,omitempty" structs:"visibility,omitempty"`
+	Properties       []EntityProperty   `json:"properties,omitempty" structs:"properties,omitempty"`
+}
+
+type EntityProperty = core.EntityProperty
 
 // TimeTracking represents the timetracking fields of a Jira issue.
 type TimeTracking struct {
@@ -482,6 +496,9 @@ type Comment struct {
 	Created      string             `json:"created,omitempty" structs:"created,omitempty"`
 	Visibility   *CommentVisibility `json:"visibility,omitempty" structs:"visibility,omitempty"`
 
+	// RenderedBody is the body rendered as HTML, if expanded with "renderedBody".
+	RenderedBody string `json:"renderedBody,omitempty" structs:"renderedBody,omitempty"`
+
 	// A list of comment properties. Optional on create and update.
 	Properties []EntityProperty `json:"properties,omitempty" structs:"properties,omitempty"`
 }
@@ -594,6 +611,10 @@ type searchResult struct {
 	Total      int     `json:"total" structs:"total"`
 }
 
+func (r *searchResult) Page() core.Page {
+	return core.Page{StartAt: r.StartAt, MaxResults: r.MaxResults, Total: r.Total}
+}
+
 // searchResultV2 is only a small wrapper around the Jira Cloud-specific SearchV2 (with JQL) method
 // to be able to parse the results
 type searchResultV2 struct {
@@ -612,6 +633,10 @@ type searchResultV2 struct {
 	NextPageToken string `json:"nextPageToken" structs:"nextPageToken"`
 }
 
+func (r *searchResultV2) Page() core.Page {
+	return core.Page{IsLast: r.IsLast, NextPageToken: r.NextPageToken}
+}
+
 // GetQueryOptions specifies the optional parameters for the Get Issue methods
 type GetQueryOptions struct {
 	// Fields is the list of fields to return for the issue. By default, all fields are returned.
@@ -625,6 +650,52 @@ type GetQueryOptions struct {
 	ProjectKeys   string `url:"projectKeys,omitempty"`
 }
 
+// CommentListOptions specifies the optional parameters of the GetComments methods.
+type CommentListOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// OrderBy orders the comments by their creation date: "created" (oldest first) or "-created" (newest first).
+	OrderBy string `url:"orderBy,omitempty"`
+	// Expand adds "renderedBody" and / or "properties" to the comments, comma separated.
+	Expand string `url:"expand,omitempty"`
+}
+
+// CommentGetOptions specifies the optional parameters of the GetComment methods.
+type CommentGetOptions struct {
+	// Expand adds "renderedBody" and / or "properties" to the comments, comma separated.
+	Expand string `url:"expand,omitempty"`
+}
+
+// commentListResult is only a small wrapper around the GetComments method
+// to be able to parse the results
+type commentListResult struct {
+	StartAt    int       `json:"startAt"`
+	MaxResults int       `json:"maxResults"`
+	Total      int       `json:"total"`
+	Comments   []Comment `json:"comments"`
+}
+
+func (r *commentListResult) Page() core.Page {
+	return core.Page{StartAt: r.StartAt, MaxResults: r.MaxResults, Total: r.Total}
+}
+
+// maxCommentIDs is the maximum number of comment IDs of a GetCommentsByIDs request.
+const maxCommentIDs = 1000
+
+// commentIDsResult is only a small wrapper around the GetCommentsByIDs method
+// to be able to parse the results
+type commentIDsResult struct {
+	StartAt    int       `json:"startAt"`
+	MaxResults int       `json:"maxResults"`
+	Total      int       `json:"total"`
+	IsLast     bool      `json:"isLast"`
+	Values     []Comment `json:"values"`
+}
+
+func (r *commentIDsResult) Page() core.Page {
+	return core.Page{StartAt: r.StartAt, MaxResults: r.MaxResults, Total: r.Total, IsLast: r.IsLast}
+}
+
 // GetWorklogsQueryOptions specifies the optional parameters for the Get Worklogs method
 type GetWorklogsQueryOptions struct {
 	StartAt      int64  `url:"startAt,omitempty"`
@@ -633,8 +704,25 @@ type GetWorklogsQueryOptions struct {
 	Expand       string `url:"expand,omitempty"`
 }
 
+// The modes of adjusting the remaining estimate of an issue when a worklog is added, updated or deleted,
+// used as AdjustEstimate of the worklog query options.
+const (
+	// AdjustEstimateAuto reduces (or, on delete, increases) the remaining estimate by the time spent.
+	AdjustEstimateAuto = "auto"
+	// AdjustEstimateLeave leaves the remaining estimate unchanged.
+	AdjustEstimateLeave = "leave"
+	// AdjustEstimateNew sets the remaining estimate to NewEstimate.
+	AdjustEstimateNew = "new"
+	// AdjustEstimateManual reduces the remaining estimate by ReduceBy (add) or increases it by IncreaseBy (delete).
+	// It is not supported when updating a worklog.
+	AdjustEstimateManual = "manual"
+)
+
+// AddWorklogQueryOptions specifies the optional parameters for the Add Worklog method
 type AddWorklogQueryOptions struct {
-	NotifyUsers          bool   `url:"notifyUsers,omitempty"`
+	// NotifyUsers can be set to false to not notify the watchers. Default: true.
+	NotifyUsers *bool `url:"notifyUsers,omitempty"`
+	// AdjustEstimate is one of AdjustEstimateAuto, AdjustEstimateLeave, AdjustEstimateNew and AdjustEstimateManual.
 	AdjustEstimate       string `url:"adjustEstimate,omitempty"`
 	NewEstimate          string `url:"newEstimate,omitempty"`
 	ReduceBy             string `url:"reduceBy,omitempty"`
@@ -642,6 +730,28 @@ type AddWorklogQueryOptions struct {
 	OverrideEditableFlag bool   `url:"overrideEditableFlag,omitempty"`
 }
 
+// UpdateWorklogQueryOptions specifies the optional parameters for the Update Worklog method
+type UpdateWorklogQueryOptions struct {
+	// NotifyUsers can be set to false to not notify the watchers. Default: true.
+	NotifyUsers *bool `url:"notifyUsers,omitempty"`
+	// AdjustEstimate is one of AdjustEstimateAuto, AdjustEstimateLeave and AdjustEstimateNew.
+	AdjustEstimate       string `url:"adjustEstimate,omitempty"`
+	NewEstimate          string `url:"newEstimate,omitempty"`
+	Expand               string `url:"expand,omitempty"`
+	OverrideEditableFlag bool   `url:"overrideEditableFlag,omitempty"`
+}
+
+// DeleteWorklogQueryOptions specifies the optional parameters for the Delete Worklog method
+type DeleteWorklogQueryOptions struct {
+	// NotifyUsers can be set to false to not notify the watchers. Default: true.
+	NotifyUsers *bool `url:"notifyUsers,omitempty"`
+	// AdjustEstimate is one of AdjustEstimateAuto, AdjustEstimateLeave, AdjustEstimateNew and AdjustEstimateManual.
+	AdjustEstimate       string `url:"adjustEstimate,omitempty"`
+	NewEstimate          string `url:"newEstimate,omitempty"`
+	IncreaseBy           string `url:"increaseBy,omitempty"`
+	OverrideEditableFlag bool   `url:"overrideEditableFlag,omitempty"`
+}
+
 // CustomFields represents custom fields of Jira
 // This can heavily differ between Jira instances
 type CustomFields map[string]string
@@ -883,6 +993,14 @@ func WithQueryOptions(options interface{}) func(*http.Request) error {
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) Create(ctx context.Context, issue *Issue) (*Issue, *Response, error) {
+	if r := s.client.UserResolver; r != nil {
+		resolved, err := r.ResolveIssue(ctx, issue)
+		if err != nil {
+			return nil, nil, err
+		}
+		issue = resolved
+	}
+
 	apiEndpoint := "rest/api/2/issue"
 	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, issue)
 	if err != nil {
@@ -914,6 +1032,14 @@ func (s *IssueService) Create(ctx context.Context, issue *Issue) (*Issue, *Respo
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) Update(ctx context.Context, issue *Issue, opts *UpdateQueryOptions) (*Issue, *Response, error) {
+	if r := s.client.UserResolver; r != nil {
+		resolved, err := r.ResolveIssue(ctx, issue)
+		if err != nil {
+			return nil, nil, err
+		}
+		issue = resolved
+	}
+
 	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%v", issue.Key)
 	url, err := addOptions(apiEndpoint, opts)
 	if err != nil {
@@ -943,6 +1069,14 @@ func (s *IssueService) Update(ctx context.Context, issue *Issue, opts *UpdateQue
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) UpdateIssue(ctx context.Context, jiraID string, data map[string]interface{}) (*Response, error) {
+	if r := s.client.UserResolver; r != nil {
+		resolved, err := r.resolveMap(ctx, "", data)
+		if err != nil {
+			return nil, err
+		}
+		data = resolved
+	}
+
 	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%v", jiraID)
 	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, data)
 	if err != nil {
@@ -1031,6 +1165,115 @@ func (s *IssueService) DeleteComment(ctx context.Context, issueID, commentID str
 	return nil
 }
 
+// GetComments returns a page of the comments of the given issue.
+// Unlike the comments embedded in IssueFields, which are capped, all comments can be fetched
+// by paging with options.StartAt, or with GetCommentsPages.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-comments/#api-rest-api-2-issue-issueidorkey-comment-get
+func (s *IssueService) GetComments(ctx context.Context, issueID string, options *CommentListOptions) ([]Comment, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/api/2/issue/%s/comment", issueID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(commentListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Comments, resp, nil
+}
+
+// GetCommentsPages calls f for all comments of the given issue, fetching page after page.
+// It stops and returns the error if f returns one.
+func (s *IssueService) GetCommentsPages(ctx context.Context, issueID string, options *CommentListOptions, f func(Comment) error) error {
+	opts := CommentListOptions{}
+	if options != nil {
+		opts = *options
+	}
+	if opts.MaxResults == 0 {
+		opts.MaxResults = 50
+	}
+
+	for {
+		comments, resp, err := s.GetComments(ctx, issueID, &opts)
+		if err != nil {
+			return err
+		}
+		for _, comment := range comments {
+			if err := f(comment); err != nil {
+				return err
+			}
+		}
+
+		opts.StartAt += len(comments)
+		if len(comments) == 0 || opts.StartAt >= resp.Total {
+			return nil
+		}
+	}
+}
+
+// GetComment returns the comment with the given ID of the given issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-comments/#api-rest-api-2-issue-issueidorkey-comment-id-get
+func (s *IssueService) GetComment(ctx context.Context, issueID, commentID string, options *CommentGetOptions) (*Comment, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/api/2/issue/%s/comment/%s", issueID, commentID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(Comment)
+	resp, err := s.client.Do(req, comment)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return comment, resp, nil
+}
+
+// GetCommentsByIDs returns the comments with the given IDs, of any issue, ordered by ID.
+// Comments the user is not allowed to see are left out.
+// Jira returns at most 1000 comments per request, more IDs are fetched in several requests.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-comments/#api-rest-api-2-comment-list-post
+func (s *IssueService) GetCommentsByIDs(ctx context.Context, commentIDs []int, options *CommentGetOptions) ([]Comment, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/comment/list", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var comments []Comment
+	var resp *Response
+	for start := 0; start < len(commentIDs); start += maxCommentIDs {
+		end := start + maxCommentIDs
+		if end > len(commentIDs) {
+			end = len(commentIDs)
+		}
+		body := struct {
+			IDs []int `json:"ids"`
+		}{commentIDs[start:end]}
+		req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, body)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		result := new(commentIDsResult)
+		resp, err = s.client.Do(req, result)
+		if err != nil {
+			return nil, resp, NewJiraError(resp, err)
+		}
+		comments = append(comments, result.Values...)
+	}
+	return comments, resp, nil
+}
+
 // AddWorklogRecord adds a new worklog record to issueID.
 //
 // https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-issue-issueIdOrKey-worklog-post
@@ -1091,6 +1334,57 @@ func (s *IssueService) UpdateWorklogRecord(ctx context.Context, issueID, worklog
 	return responseRecord, resp, nil
 }
 
+// GetWorklog returns the worklog with the given ID of the given issue.
+// Use WithQueryOptions(&GetWorklogsQueryOptions{Expand: "properties"}) to include the worklog properties.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklogs/#api-rest-api-2-issue-issueidorkey-worklog-id-get
+func (s *IssueService) GetWorklog(ctx context.Context, issueID, worklogID string, options ...func(*http.Request) error) (*WorklogRecord, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/worklog/%s", issueID, worklogID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, option := range options {
+		err = option(req)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	record := new(WorklogRecord)
+	resp, err := s.client.Do(req, record)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return record, resp, nil
+}
+
+// DeleteWorklogRecord deletes the worklog with the given ID of the given issue.
+// Use WithQueryOptions(&DeleteWorklogQueryOptions{...}) to adjust the remaining estimate.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklogs/#api-rest-api-2-issue-issueidorkey-worklog-id-delete
+func (s *IssueService) DeleteWorklogRecord(ctx context.Context, issueID, worklogID string, options ...func(*http.Request) error) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/worklog/%s", issueID, worklogID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, option := range options {
+		err = option(req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
 // AddLink adds a link between two issues.
 //
 // Jira API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issueLink
@@ -1528,6 +1822,14 @@ func (s *IssueService) GetWatchers(ctx context.Context, issueID string) (*[]User
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) AddWatcher(ctx context.Context, issueID string, userName string) (*Response, error) {
+	if r := s.client.UserResolver; r != nil {
+		accountID, err := r.AccountID(ctx, userName)
+		if err != nil {
+			return nil, err
+		}
+		userName = accountID
+	}
+
 	apiEndPoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", issueID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndPoint, userName)
@@ -1551,6 +1853,14 @@ func (s *IssueService) AddWatcher(ctx context.Context, issueID string, userName
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) RemoveWatcher(ctx context.Context, issueID string, userName string) (*Response, error) {
+	if r := s.client.UserResolver; r != nil {
+		accountID, err := r.AccountID(ctx, userName)
+		if err != nil {
+			return nil, err
+		}
+		userName = accountID
+	}
+
 	apiEndPoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", issueID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndPoint, userName)
@@ -1566,6 +1876,157 @@ func (s *IssueService) RemoveWatcher(ctx context.Context, issueID string, userNa
 	return resp, err
 }
 
+// GetWatches returns the watchers of the given issue as returned by Jira,
+// without looking up the details of every user like GetWatchers.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-watchers/#api-rest-api-2-issue-issueidorkey-watchers-get
+func (s *IssueService) GetWatches(ctx context.Context, issueID string) (*Watches, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", issueID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	watches := new(Watches)
+	resp, err := s.client.Do(req, watches)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return watches, resp, nil
+}
+
+// AddWatcherByAccountID adds the user with the given account ID as watcher to the given issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-watchers/#api-rest-api-2-issue-issueidorkey-watchers-post
+func (s *IssueService) AddWatcherByAccountID(ctx context.Context, issueID, accountID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", issueID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// RemoveWatcherByAccountID removes the user with the given account ID as watcher from the given issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-watchers/#api-rest-api-2-issue-issueidorkey-watchers-delete
+func (s *IssueService) RemoveWatcherByAccountID(ctx context.Context, issueID, accountID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/watchers?accountId=%s", issueID, url.QueryEscape(accountID))
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// GetVotes returns the votes of the given issue.
+// The voters are only included if the user has the permission to view them.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-votes/#api-rest-api-2-issue-issueidorkey-votes-get
+func (s *IssueService) GetVotes(ctx context.Context, issueID string) (*Votes, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/votes", issueID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	votes := new(Votes)
+	resp, err := s.client.Do(req, votes)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return votes, resp, nil
+}
+
+// AddVote adds the vote of the current user to the given issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-votes/#api-rest-api-2-issue-issueidorkey-votes-post
+func (s *IssueService) AddVote(ctx context.Context, issueID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/votes", issueID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// RemoveVote removes the vote of the current user from the given issue.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-votes/#api-rest-api-2-issue-issueidorkey-votes-delete
+func (s *IssueService) RemoveVote(ctx context.Context, issueID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/votes", issueID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Notify sends an email notification about the given issue.
+// The notification is queued by Jira and sent asynchronously.
+// If a UserResolver is configured, the users of the recipients may also be given by their Name or EmailAddress.
+// Otherwise an error is returned for users without an AccountID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-issueidorkey-notify-post
+func (s *IssueService) Notify(ctx context.Context, issueID string, notification *Notification) (*Response, error) {
+	if notification != nil && notification.To != nil && len(notification.To.Users) > 0 {
+		to := *notification.To
+		to.Users = make([]NotificationUser, 0, len(notification.To.Users))
+		for _, u := range notification.To.Users {
+			if u.AccountID == "" {
+				user := u.Name
+				if user == "" {
+					user = u.EmailAddress
+				}
+				if s.client.UserResolver == nil {
+					return nil, fmt.Errorf("recipient %q has no account ID and no UserResolver is configured", user)
+				}
+				accountID, err := s.client.UserResolver.AccountID(ctx, user)
+				if err != nil {
+					return nil, err
+				}
+				u = NotificationUser{AccountID: accountID}
+			}
+			to.Users = append(to.Users, u)
+		}
+		resolved := *notification
+		resolved.To = &to
+		notification = &resolved
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/notify", issueID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, notification)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
 // UpdateAssignee updates the user assigned to work on the given issue
 //
 // Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.2/#api/2/issue-assign
@@ -1574,6 +2035,14 @@ func (s *IssueService) RemoveWatcher(ctx context.Context, issueID string, userNa
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) UpdateAssignee(ctx context.Context, issueID string, assignee *User) (*Response, error) {
+	if r := s.client.UserResolver; r != nil {
+		resolved, err := r.ResolveUser(ctx, assignee)
+		if err != nil {
+			return nil, err
+		}
+		assignee = resolved
+	}
+
 	apiEndPoint := fmt.Sprintf("rest/api/2/issue/%s/assignee", issueID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndPoint, assignee)
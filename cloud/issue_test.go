@@ -272,6 +272,23 @@ func TestIssueService_AddWorklogRecord(t *testing.T) {
 	}
 }
 
+func TestIssueService_AddWorklogRecord_NotifyUsers(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/worklog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestParams(t, r, map[string]string{"notifyUsers": "false"})
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"100028","issueId":"10000","timeSpentSeconds":3600}`)
+	})
+
+	notify := false
+	options := &AddWorklogQueryOptions{NotifyUsers: &notify}
+	if _, _, err := testClient.Issue.AddWorklogRecord(context.Background(), "10000", &WorklogRecord{TimeSpent: "1h"}, WithQueryOptions(options)); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
 func TestIssueService_UpdateWorklogRecord(t *testing.T) {
 	setup()
 	defer teardown()
@@ -1931,3 +1948,257 @@ func TestTime_MarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestIssueService_GetWatches(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/watchers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/watchers","isWatching":true,"watchCount":1,"watchers":[{"accountId":"5b10a2844c20165700ede21g","displayName":"Fred F. User","active":true}]}`)
+	})
+
+	watches, _, err := testClient.Issue.GetWatches(context.Background(), "EX-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if watches.WatchCount != 1 || !watches.IsWatching || watches.Watchers[0].AccountID != "5b10a2844c20165700ede21g" {
+		t.Errorf("Unexpected watches: %+v", watches)
+	}
+}
+
+func TestIssueService_AddRemoveWatcherByAccountID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/watchers", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			var accountID string
+			json.NewDecoder(r.Body).Decode(&accountID)
+			if accountID != "5b10a2844c20165700ede21g" {
+				t.Errorf("Unexpected account ID %q", accountID)
+			}
+		case http.MethodDelete:
+			testRequestParams(t, r, map[string]string{"accountId": "5b10a2844c20165700ede21g"})
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Issue.AddWatcherByAccountID(context.Background(), "EX-1", "5b10a2844c20165700ede21g"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Issue.RemoveWatcherByAccountID(context.Background(), "EX-1", "5b10a2844c20165700ede21g"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_Votes(t *testing.T) {
+	setup()
+	defer teardown()
+	methods := map[string]int{}
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/votes", func(w http.ResponseWriter, r *http.Request) {
+		methods[r.Method]++
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/issue/EX-1/votes","votes":2,"hasVoted":true,"voters":[{"accountId":"5b10a2844c20165700ede21g","displayName":"Fred F. User"}]}`)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	votes, _, err := testClient.Issue.GetVotes(context.Background(), "EX-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if votes.Votes != 2 || !votes.HasVoted || len(votes.Voters) != 1 {
+		t.Errorf("Unexpected votes: %+v", votes)
+	}
+	if _, err := testClient.Issue.AddVote(context.Background(), "EX-1"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Issue.RemoveVote(context.Background(), "EX-1"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if methods[http.MethodPost] != 1 || methods[http.MethodDelete] != 1 {
+		t.Errorf("Unexpected requests: %v", methods)
+	}
+}
+
+func TestIssueService_Notify(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/notify", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Error decoding body: %s", err)
+		}
+		want := `map[htmlBody:<b>Outage</b> subject:Incident textBody:Outage to:map[assignee:true groups:[map[name:on-call]] users:[map[accountId:5b10a2844c20165700ede21g]] watchers:true]]`
+		if got := fmt.Sprint(body); got != want {
+			t.Errorf("Body = %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	notification := &Notification{
+		Subject:  "Incident",
+		TextBody: "Outage",
+		HTMLBody: "<b>Outage</b>",
+		To: &NotificationRecipients{
+			Assignee: true,
+			Watchers: true,
+			Users:    []NotificationUser{{AccountID: "5b10a2844c20165700ede21g"}},
+			Groups:   []NotificationGroup{{Name: "on-call"}},
+		},
+	}
+	if _, err := testClient.Issue.Notify(context.Background(), "EX-1", notification); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_Notify_WithoutAccountID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/notify", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Unexpected notification without account ID")
+	})
+
+	notification := &Notification{TextBody: "Outage", To: &NotificationRecipients{Users: []NotificationUser{{Name: "fred"}}}}
+	if _, err := testClient.Issue.Notify(context.Background(), "EX-1", notification); err == nil {
+		t.Error("Expected an error for a recipient without account ID")
+	}
+}
+
+func TestIssueService_GetComments(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"startAt": "1", "maxResults": "2", "orderBy": "-created", "expand": "renderedBody"})
+		fmt.Fprint(w, `{"startAt":1,"maxResults":2,"total":3,"comments":[{"id":"10001","body":"*second*","renderedBody":"<b>second</b>"},{"id":"10000","body":"first"}]}`)
+	})
+
+	comments, resp, err := testClient.Issue.GetComments(context.Background(), "EX-1", &CommentListOptions{StartAt: 1, MaxResults: 2, OrderBy: "-created", Expand: "renderedBody"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(comments) != 2 || comments[0].RenderedBody != "<b>second</b>" {
+		t.Errorf("Unexpected comments: %+v", comments)
+	}
+	if resp.StartAt != 1 || resp.Total != 3 {
+		t.Errorf("Unexpected paging: startAt %d, total %d", resp.StartAt, resp.Total)
+	}
+}
+
+func TestIssueService_GetCommentsPages(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":2,"total":3,"comments":[{"id":"10000"},{"id":"10001"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"startAt":2,"maxResults":2,"total":3,"comments":[{"id":"10002"}]}`)
+		default:
+			t.Errorf("Unexpected startAt %s", r.URL.Query().Get("startAt"))
+		}
+	})
+
+	var ids []string
+	err := testClient.Issue.GetCommentsPages(context.Background(), "EX-1", &CommentListOptions{MaxResults: 2}, func(c Comment) error {
+		ids = append(ids, c.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got := fmt.Sprint(ids); got != "[10000 10001 10002]" {
+		t.Errorf("Unexpected comments %s", got)
+	}
+}
+
+func TestIssueService_GetComment(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/comment/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"expand": "properties"})
+		fmt.Fprint(w, `{"id":"10000","body":"first","properties":[{"key":"archived","value":true}]}`)
+	})
+
+	comment, _, err := testClient.Issue.GetComment(context.Background(), "EX-1", "10000", &CommentGetOptions{Expand: "properties"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if comment.ID != "10000" || len(comment.Properties) != 1 || comment.Properties[0].Key != "archived" {
+		t.Errorf("Unexpected comment: %+v", comment)
+	}
+}
+
+func TestIssueService_GetCommentsByIDs(t *testing.T) {
+	setup()
+	defer teardown()
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/comment/list", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		requests++
+		var body struct {
+			IDs []int `json:"ids"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Error decoding body: %s", err)
+		}
+		values := make([]string, 0, len(body.IDs))
+		for _, id := range body.IDs {
+			values = append(values, fmt.Sprintf(`{"id":"%d"}`, id))
+		}
+		fmt.Fprintf(w, `{"startAt":0,"maxResults":1000,"total":%d,"isLast":true,"values":[%s]}`, len(values), strings.Join(values, ","))
+	})
+
+	ids := make([]int, 1500)
+	for i := range ids {
+		ids[i] = 10000 + i
+	}
+	comments, _, err := testClient.Issue.GetCommentsByIDs(context.Background(), ids, nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if requests != 2 || len(comments) != 1500 || comments[1499].ID != "11499" {
+		t.Errorf("Expected 1500 comments in 2 requests, got %d comments in %d requests", len(comments), requests)
+	}
+}
+
+func TestIssueService_GetWorklog(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/worklog/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"expand": "properties"})
+		fmt.Fprint(w, `{"id":"10000","issueId":"10010","timeSpentSeconds":3600,"visibility":{"type":"group","value":"jira-developers"},"properties":[{"key":"billable","value":true}]}`)
+	})
+
+	worklog, _, err := testClient.Issue.GetWorklog(context.Background(), "EX-1", "10000", WithQueryOptions(&GetWorklogsQueryOptions{Expand: "properties"}))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if worklog.ID != "10000" || worklog.Visibility == nil || worklog.Visibility.Value != "jira-developers" || len(worklog.Properties) != 1 {
+		t.Errorf("Unexpected worklog: %+v", worklog)
+	}
+}
+
+func TestIssueService_DeleteWorklogRecord(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/worklog/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestParams(t, r, map[string]string{"adjustEstimate": "manual", "increaseBy": "1h", "notifyUsers": "false"})
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	notify := false
+	options := &DeleteWorklogQueryOptions{AdjustEstimate: AdjustEstimateManual, IncreaseBy: "1h", NotifyUsers: &notify}
+	if _, err := testClient.Issue.DeleteWorklogRecord(context.Background(), "EX-1", "10000", WithQueryOptions(options)); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
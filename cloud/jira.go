@@ -3,16 +3,13 @@ package cloud
 import (
 	"bytes"
 	"context"
-	"encoding/json"
-	"fmt"
 	"io"
 	"net/http"
 	"net/url"
-	"reflect"
 	"strings"
 	"sync"
 
-	"github.com/google/go-querystring/query"
+	"github.com/andygrunwald/go-jira/v2/internal/core"
 )
 
 const (
@@ -34,6 +31,11 @@ type Client struct {
 	// User agent used when communicating with the Jira API.
 	UserAgent string
 
+	// UserResolver converts usernames and email addresses to account IDs
+	// in the payloads of the issue, watcher, assignee and group calls, if set.
+	// See NewUserResolver.
+	UserResolver *UserResolver
+
 	// Reuse a single struct instead of allocating one for each service on the heap.
 	common service
 
@@ -59,6 +61,11 @@ type Client struct {
 	ServiceDesk      *ServiceDeskService
 	Customer         *CustomerService
 	Request          *RequestService
+	Webhook          *WebhookService
+	Task             *TaskService
+	Property         *PropertyService
+	Worklog          *WorklogService
+	TimeTracking     *TimeTrackingService
 }
 
 // service is the base structure to bundle API services
@@ -121,6 +128,11 @@ func NewClient(baseURL string, httpClient *http.Client) (*Client, error) {
 	c.ServiceDesk = (*ServiceDeskService)(&c.common)
 	c.Customer = (*CustomerService)(&c.common)
 	c.Request = (*RequestService)(&c.common)
+	c.Webhook = (*WebhookService)(&c.common)
+	c.Task = (*TaskService)(&c.common)
+	c.Property = (*PropertyService)(&c.common)
+	c.Worklog = (*WorklogService)(&c.common)
+	c.TimeTracking = (*TimeTrackingService)(&c.common)
 
 	return c, nil
 }
@@ -130,22 +142,11 @@ func NewClient(baseURL string, httpClient *http.Client) (*Client, error) {
 // A relative URL can be provided in urlStr, in which case it is resolved relative to the baseURL of the Client.
 // Allows using an optional native io.Reader for sourcing the request body.
 func (c *Client) NewRawRequest(ctx context.Context, method, urlStr string, body io.Reader) (*http.Request, error) {
-	rel, err := url.Parse(urlStr)
-	if err != nil {
-		return nil, err
-	}
-	// Relative URLs should be specified without a preceding slash since baseURL will have the trailing slash
-	rel.Path = strings.TrimLeft(rel.Path, "/")
-
-	u := c.BaseURL.ResolveReference(rel)
-
-	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	req, err := core.NewRawRequest(ctx, c.BaseURL, method, urlStr, body)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-
 	return req, nil
 }
 
@@ -153,105 +154,36 @@ func (c *Client) NewRawRequest(ctx context.Context, method, urlStr string, body
 // A relative URL can be provided in urlStr, in which case it is resolved relative to the BaseURL of the Client.
 // If specified, the value pointed to by body is JSON encoded and included as the request body.
 func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
-	rel, err := url.Parse(urlStr)
-	if err != nil {
-		return nil, err
-	}
-	// Relative URLs should be specified without a preceding slash since BaseURL will have the trailing slash
-	rel.Path = strings.TrimLeft(rel.Path, "/")
-
-	u := c.BaseURL.ResolveReference(rel)
-
-	// TODO This part is the difference between NewRawRequestWithContext
-	// Check if we can get this working in one function
-	var buf io.ReadWriter
-	if body != nil {
-		buf = new(bytes.Buffer)
-		err = json.NewEncoder(buf).Encode(body)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
+	req, err := core.NewRequest(ctx, c.BaseURL, method, urlStr, body)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-
 	return req, nil
 }
 
 // addOptions adds the parameters in opts as URL query parameters to s. opts
 // must be a struct whose fields may contain "url" tags.
 func addOptions(s string, opts interface{}) (string, error) {
-	v := reflect.ValueOf(opts)
-	if v.Kind() == reflect.Ptr && v.IsNil() {
-		return s, nil
-	}
-
-	u, err := url.Parse(s)
-	if err != nil {
-		return s, err
-	}
-
-	qs, err := query.Values(opts)
-	if err != nil {
-		return s, err
-	}
-
-	u.RawQuery = qs.Encode()
-	return u.String(), nil
+	return core.AddOptions(s, opts)
 }
 
 // NewMultiPartRequest creates an API request including a multi-part file.
 // A relative URL can be provided in urlStr, in which case it is resolved relative to the baseURL of the Client.
 // If specified, the value pointed to by buf is a multipart form.
 func (c *Client) NewMultiPartRequest(ctx context.Context, method, urlStr string, buf *bytes.Buffer) (*http.Request, error) {
-	rel, err := url.Parse(urlStr)
-	if err != nil {
-		return nil, err
-	}
-	// Relative URLs should be specified without a preceding slash since baseURL will have the trailing slash
-	rel.Path = strings.TrimLeft(rel.Path, "/")
-
-	u := c.BaseURL.ResolveReference(rel)
-
-	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
+	req, err := core.NewMultiPartRequest(ctx, c.BaseURL, method, urlStr, buf)
 	if err != nil {
 		return nil, err
 	}
 
-	// Set required headers
-	req.Header.Set("X-Atlassian-Token", "nocheck")
-
 	return req, nil
 }
 
 // Do sends an API request and returns the API response.
 // The API response is JSON decoded and stored in the value pointed to by v, or returned as an error if an API error has occurred.
 func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
-	httpResp, err := c.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	err = CheckResponse(httpResp)
-	if err != nil {
-		// Even though there was an error, we still return the response
-		// in case the caller wants to inspect it further
-		return newResponse(httpResp, nil), err
-	}
-
-	if v != nil {
-		// Open a NewDecoder and defer closing the reader only if there is a provided interface to decode to
-		defer httpResp.Body.Close()
-		err = json.NewDecoder(httpResp.Body).Decode(v)
-	}
-
-	resp := newResponse(httpResp, v)
-	return resp, err
+	return core.Do(c.client, req, v)
 }
 
 // CheckResponse checks the API response for errors, and returns them if present.
@@ -259,48 +191,12 @@ func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
 // The caller is responsible to analyze the response body.
 // The body can contain JSON (if the error is intended) or xml (sometimes Jira just failes).
 func CheckResponse(r *http.Response) error {
-	if c := r.StatusCode; 200 <= c && c <= 299 {
-		return nil
-	}
-
-	err := fmt.Errorf("request failed. Please analyze the request body for more details. Status code: %d", r.StatusCode)
-	return err
+	return core.CheckResponse(r)
 }
 
 // Response represents Jira API response. It wraps http.Response returned from
 // API and provides information about paging.
-type Response struct {
-	*http.Response
-
-	StartAt    int
-	MaxResults int
-	Total      int
-
-	// *searchResultV2
-	IsLast        bool
-	NextPageToken string
-}
-
-func newResponse(r *http.Response, v interface{}) *Response {
-	resp := &Response{Response: r}
-	resp.populatePageValues(v)
-	return resp
-}
-
-// Sets paging values if response json was parsed to searchResult type
-// (can be extended with other types if they also need paging info)
-func (r *Response) populatePageValues(v interface{}) {
-	switch value := v.(type) {
-	case *searchResult:
-		r.StartAt = value.StartAt
-		r.MaxResults = value.MaxResults
-		r.Total = value.Total
-	case *searchResultV2:
-		r.IsLast = value.IsLast
-		r.NextPageToken = value.NextPageToken
-	case *groupMembersResult:
-		r.StartAt = value.StartAt
-		r.MaxResults = value.MaxResults
-		r.Total = value.Total
-	}
-}
+//
+// Paging values are set if the response JSON was parsed into a type
+// implementing core.Pager, like searchResult.
+type Response = core.Response
@@ -170,11 +170,19 @@ func TestOrganizationService_SetProperty(t *testing.T) {
 		testMethod(t, r, http.MethodPut)
 		testRequestURL(t, r, "/rest/servicedeskapi/organization/1/property/organization.attributes")
 
+		var value map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&value); err != nil {
+			t.Fatalf("Error decoding body: %s", err)
+		}
+		if value["phone"] != "0800-1234" {
+			t.Errorf("Unexpected value: %v", value)
+		}
+
 		w.WriteHeader(http.StatusOK)
 	})
 
 	key := "organization.attributes"
-	_, err := testClient.Organization.SetProperty(context.Background(), 1, key)
+	_, err := testClient.Organization.SetProperty(context.Background(), 1, key, map[string]string{"phone": "0800-1234"})
 
 	if err != nil {
 		t.Errorf("Error given: %s", err)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/andygrunwald/go-jira/v2/internal/core"
 	"github.com/google/go-querystring/query"
 )
 
@@ -13,6 +14,13 @@ import (
 // Jira API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/project
 type ProjectService service
 
+// Project type keys
+const (
+	ProjectTypeSoftware    = "software"
+	ProjectTypeBusiness    = "business"
+	ProjectTypeServiceDesk = "service_desk"
+)
+
 // ProjectList represent a list of Projects
 type ProjectList []struct {
 	Expand          string          `json:"expand" structs:"expand"`
@@ -52,6 +60,107 @@ type Project struct {
 	Roles           map[string]string  `json:"roles,omitempty" structs:"roles,omitempty"`
 	AvatarUrls      AvatarUrls         `json:"avatarUrls,omitempty" structs:"avatarUrls,omitempty"`
 	ProjectCategory ProjectCategory    `json:"projectCategory,omitempty" structs:"projectCategory,omitempty"`
+	ProjectTypeKey  string             `json:"projectTypeKey,omitempty" structs:"projectTypeKey,omitempty"`
+}
+
+// ProjectCreateOptions are passed to the ProjectService.Create function to create a new Jira project
+type ProjectCreateOptions struct {
+	// Key: The project key. It must be unique, start with an uppercase letter
+	// and consist of uppercase letters and numbers. Required.
+	Key string `json:"key" structs:"key"`
+
+	// Name: The name of the project. Required.
+	Name string `json:"name" structs:"name"`
+
+	// ProjectTypeKey: The type of the project, e.g. ProjectTypeSoftware.
+	// Required unless ProjectTemplateKey is set.
+	ProjectTypeKey string `json:"projectTypeKey,omitempty" structs:"projectTypeKey,omitempty"`
+
+	// ProjectTemplateKey: The template the project is created from,
+	// e.g. "com.pyxis.greenhopper.jira:gh-simplified-scrum-classic".
+	ProjectTemplateKey string `json:"projectTemplateKey,omitempty" structs:"projectTemplateKey,omitempty"`
+
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+
+	// LeadAccountID: The accountId of the project lead. Required.
+	LeadAccountID string `json:"leadAccountId,omitempty" structs:"leadAccountId,omitempty"`
+
+	URL string `json:"url,omitempty" structs:"url,omitempty"`
+
+	// AssigneeType: The default assignee of new issues, "PROJECT_LEAD" or "UNASSIGNED".
+	AssigneeType string `json:"assigneeType,omitempty" structs:"assigneeType,omitempty"`
+
+	AvatarID                 int `json:"avatarId,omitempty" structs:"avatarId,omitempty"`
+	IssueSecurityScheme      int `json:"issueSecurityScheme,omitempty" structs:"issueSecurityScheme,omitempty"`
+	PermissionScheme         int `json:"permissionScheme,omitempty" structs:"permissionScheme,omitempty"`
+	NotificationScheme       int `json:"notificationScheme,omitempty" structs:"notificationScheme,omitempty"`
+	CategoryID               int `json:"categoryId,omitempty" structs:"categoryId,omitempty"`
+	FieldConfigurationScheme int `json:"fieldConfigurationScheme,omitempty" structs:"fieldConfigurationScheme,omitempty"`
+	IssueTypeScheme          int `json:"issueTypeScheme,omitempty" structs:"issueTypeScheme,omitempty"`
+	IssueTypeScreenScheme    int `json:"issueTypeScreenScheme,omitempty" structs:"issueTypeScreenScheme,omitempty"`
+	WorkflowScheme           int `json:"workflowScheme,omitempty" structs:"workflowScheme,omitempty"`
+}
+
+// ProjectUpdateOptions are passed to the ProjectService.Update function to update a Jira project.
+// Only the set fields are updated.
+type ProjectUpdateOptions struct {
+	Key                 string `json:"key,omitempty" structs:"key,omitempty"`
+	Name                string `json:"name,omitempty" structs:"name,omitempty"`
+	Description         string `json:"description,omitempty" structs:"description,omitempty"`
+	LeadAccountID       string `json:"leadAccountId,omitempty" structs:"leadAccountId,omitempty"`
+	URL                 string `json:"url,omitempty" structs:"url,omitempty"`
+	AssigneeType        string `json:"assigneeType,omitempty" structs:"assigneeType,omitempty"`
+	AvatarID            int    `json:"avatarId,omitempty" structs:"avatarId,omitempty"`
+	IssueSecurityScheme int    `json:"issueSecurityScheme,omitempty" structs:"issueSecurityScheme,omitempty"`
+	PermissionScheme    int    `json:"permissionScheme,omitempty" structs:"permissionScheme,omitempty"`
+	NotificationScheme  int    `json:"notificationScheme,omitempty" structs:"notificationScheme,omitempty"`
+	CategoryID          int    `json:"categoryId,omitempty" structs:"categoryId,omitempty"`
+}
+
+// ProjectIdentifiers identifies a created project.
+type ProjectIdentifiers struct {
+	Self string `json:"self" structs:"self"`
+	ID   int    `json:"id" structs:"id"`
+	Key  string `json:"key" structs:"key"`
+}
+
+// ProjectSearchOptions specifies the optional parameters of ProjectService.Search.
+type ProjectSearchOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+
+	// OrderBy: e.g. "name", "-key" or "lastIssueUpdatedTime".
+	OrderBy string `url:"orderBy,omitempty"`
+
+	IDs  []int    `url:"id,omitempty"`
+	Keys []string `url:"keys,omitempty"`
+
+	// Query: Matches the key or name of the project, case insensitive.
+	Query      string `url:"query,omitempty"`
+	TypeKey    string `url:"typeKey,omitempty"`
+	CategoryID int    `url:"categoryId,omitempty"`
+
+	// Action: The permission of the user on the projects, "view" (default), "browse" or "edit".
+	Action string `url:"action,omitempty"`
+
+	// Status: "live" (default), "archived" and/or "deleted".
+	Status []string `url:"status,omitempty"`
+
+	Expand string `url:"expand,omitempty"`
+}
+
+// projectSearchResult is only a small wrapper around the Search method
+// to be able to parse the results
+type projectSearchResult struct {
+	StartAt    int       `json:"startAt"`
+	MaxResults int       `json:"maxResults"`
+	Total      int       `json:"total"`
+	IsLast     bool      `json:"isLast"`
+	Values     []Project `json:"values"`
+}
+
+func (r *projectSearchResult) Page() core.Page {
+	return core.Page{StartAt: r.StartAt, MaxResults: r.MaxResults, Total: r.Total, IsLast: r.IsLast}
 }
 
 // ProjectComponent represents a single component of a project
@@ -68,6 +177,7 @@ type ProjectComponent struct {
 	IsAssigneeTypeValid bool   `json:"isAssigneeTypeValid" structs:"isAssigneeTypeValid,omitempty"`
 	Project             string `json:"project" structs:"project,omitempty"`
 	ProjectID           int    `json:"projectId" structs:"projectId,omitempty"`
+	IssueCount          int    `json:"issueCount,omitempty" structs:"issueCount,omitempty"`
 }
 
 // PermissionScheme represents the permission scheme for the project
@@ -161,3 +271,158 @@ func (s *ProjectService) GetPermissionScheme(ctx context.Context, projectID stri
 
 	return ps, resp, nil
 }
+
+// Create creates a project from a project type or template.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-post
+func (s *ProjectService) Create(ctx context.Context, options *ProjectCreateOptions) (*ProjectIdentifiers, *Response, error) {
+	apiEndpoint := "rest/api/2/project"
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project := new(ProjectIdentifiers)
+	resp, err := s.client.Do(req, project)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return project, resp, nil
+}
+
+// Update updates the project with the given ID or key.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-projectidorkey-put
+func (s *ProjectService) Update(ctx context.Context, projectID string, options *ProjectUpdateOptions) (*Project, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s", projectID)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project := new(Project)
+	resp, err := s.client.Do(req, project)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return project, resp, nil
+}
+
+// Delete deletes the project with the given ID or key.
+// With enableUndo the project is moved to the trash and can be restored for 60 days.
+// Use DeleteAsync for projects with many issues.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-projectidorkey-delete
+func (s *ProjectService) Delete(ctx context.Context, projectID string, enableUndo bool) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s?enableUndo=%t", projectID, enableUndo)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// DeleteAsync starts the deletion of the project with the given ID or key and returns the task.
+// The project is deleted permanently, without moving it to the trash.
+// Use TaskService.Wait to wait for the deletion to finish.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-projectidorkey-delete-post
+func (s *ProjectService) DeleteAsync(ctx context.Context, projectID string) (*Task, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/delete", projectID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Jira answers with 303 See Other to the task, which is followed by the HTTP client
+	task := new(Task)
+	resp, err := s.client.Do(req, task)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return task, resp, nil
+}
+
+// Archive archives the project with the given ID or key.
+// Archived projects are read-only and hidden from search.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-projectidorkey-archive-post
+func (s *ProjectService) Archive(ctx context.Context, projectID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/archive", projectID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Restore restores an archived project or a project from the trash.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-projectidorkey-restore-post
+func (s *ProjectService) Restore(ctx context.Context, projectID string) (*Project, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/restore", projectID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project := new(Project)
+	resp, err := s.client.Do(req, project)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return project, resp, nil
+}
+
+// Search returns a page of the projects visible to the user.
+// The paging values are set in the Response.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-search-get
+func (s *ProjectService) Search(ctx context.Context, options *ProjectSearchOptions) ([]Project, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/project/search", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(projectSearchResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Values, resp, nil
+}
+
+// AssignPermissionScheme assigns the permission scheme to the project with the given ID or key.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-permission-schemes/#api-rest-api-2-project-projectkeyorid-permissionscheme-put
+func (s *ProjectService) AssignPermissionScheme(ctx context.Context, projectID string, permissionSchemeID int) (*PermissionScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/permissionscheme", projectID)
+	body := struct {
+		ID int `json:"id"`
+	}{permissionSchemeID}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ps := new(PermissionScheme)
+	resp, err := s.client.Do(req, ps)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return ps, resp, nil
+}
@@ -2,6 +2,7 @@ package cloud
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
@@ -137,3 +138,173 @@ func TestProjectService_GetPermissionScheme_Success(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestProjectService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/project", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["key"] != "EX" || body["projectTemplateKey"] != "com.pyxis.greenhopper.jira:gh-simplified-scrum-classic" || body["leadAccountId"] != "5b10a0effa615349cb016cd8" {
+			t.Errorf("Unexpected request body: %v", body)
+		}
+		if _, ok := body["permissionScheme"]; ok {
+			t.Error("Expected unset schemes to be omitted")
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/2/project/10042","id":10042,"key":"EX"}`)
+	})
+
+	project, _, err := testClient.Project.Create(context.Background(), &ProjectCreateOptions{
+		Key:                "EX",
+		Name:               "Example",
+		ProjectTypeKey:     ProjectTypeSoftware,
+		ProjectTemplateKey: "com.pyxis.greenhopper.jira:gh-simplified-scrum-classic",
+		LeadAccountID:      "5b10a0effa615349cb016cd8",
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if project.ID != 10042 || project.Key != "EX" {
+		t.Errorf("Unexpected project: %+v", project)
+	}
+}
+
+func TestProjectService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/project/EX", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body) != 1 || body["name"] != "Renamed" {
+			t.Errorf("Unexpected request body: %v", body)
+		}
+		fmt.Fprint(w, `{"id":"10042","key":"EX","name":"Renamed"}`)
+	})
+
+	project, _, err := testClient.Project.Update(context.Background(), "EX", &ProjectUpdateOptions{Name: "Renamed"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if project.Name != "Renamed" {
+		t.Errorf("Unexpected project: %+v", project)
+	}
+}
+
+func TestProjectService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/project/EX", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestParams(t, r, map[string]string{"enableUndo": "true"})
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Project.Delete(context.Background(), "EX", true); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_DeleteAsync(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/project/EX/delete", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		http.Redirect(w, r, "/rest/api/2/task/1", http.StatusSeeOther)
+	})
+	testMux.HandleFunc("/rest/api/2/task/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/2/task/1","id":"1","status":"ENQUEUED","progress":0}`)
+	})
+
+	task, _, err := testClient.Project.DeleteAsync(context.Background(), "EX")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if task.ID != "1" || task.Status != TaskStatusEnqueued {
+		t.Errorf("Unexpected task: %+v", task)
+	}
+}
+
+func TestProjectService_ArchiveRestore(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/project/EX/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	testMux.HandleFunc("/rest/api/2/project/EX/restore", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"id":"10042","key":"EX"}`)
+	})
+
+	if _, err := testClient.Project.Archive(context.Background(), "EX"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	project, _, err := testClient.Project.Restore(context.Background(), "EX")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if project.Key != "EX" {
+		t.Errorf("Unexpected project: %+v", project)
+	}
+}
+
+func TestProjectService_Search(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/project/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/project/search?categoryId=10000&maxResults=2&query=ex&startAt=2&status=live&status=archived&typeKey=software")
+		fmt.Fprint(w, `{"startAt":2,"maxResults":2,"total":3,"isLast":true,"values":[{"id":"10002","key":"EX3","name":"Example 3","projectTypeKey":"software"}]}`)
+	})
+
+	projects, resp, err := testClient.Project.Search(context.Background(), &ProjectSearchOptions{
+		StartAt:    2,
+		MaxResults: 2,
+		Query:      "ex",
+		TypeKey:    ProjectTypeSoftware,
+		CategoryID: 10000,
+		Status:     []string{"live", "archived"},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(projects) != 1 || projects[0].Key != "EX3" || projects[0].ProjectTypeKey != ProjectTypeSoftware {
+		t.Errorf("Unexpected projects: %+v", projects)
+	}
+	if resp.StartAt != 2 || resp.Total != 3 || !resp.IsLast {
+		t.Errorf("Unexpected paging values: %+v", resp)
+	}
+}
+
+func TestProjectService_AssignPermissionScheme(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/project/EX/permissionscheme", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		var body map[string]int
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["id"] != 10001 {
+			t.Errorf("Unexpected request body: %v", body)
+		}
+		fmt.Fprint(w, `{"id":10001,"name":"Example permission scheme"}`)
+	})
+
+	ps, _, err := testClient.Project.AssignPermissionScheme(context.Background(), "EX", 10001)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if ps.ID != 10001 {
+		t.Errorf("Unexpected permission scheme: %+v", ps)
+	}
+}
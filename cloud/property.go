@@ -0,0 +1,280 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// PropertyService handles entity properties for the Jira instance / API.
+//
+// Entity properties store arbitrary JSON data against issues, projects, users, comments, boards and sprints,
+// e.g. the integration state of an app.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/jira-entity-properties/
+type PropertyService service
+
+// PropertyEntity is the kind of entity a property is stored against.
+type PropertyEntity string
+
+// The entities supporting properties.
+// The entity ID of PropertyEntityUser is the account ID of the user,
+// the one of PropertyEntityIssue and PropertyEntityProject can be the ID or key.
+const (
+	PropertyEntityIssue   PropertyEntity = "issue"
+	PropertyEntityProject PropertyEntity = "project"
+	PropertyEntityUser    PropertyEntity = "user"
+	PropertyEntityComment PropertyEntity = "comment"
+	PropertyEntityBoard   PropertyEntity = "board"
+	PropertyEntitySprint  PropertyEntity = "sprint"
+)
+
+// IssuePropertyFilter selects the issues of the bulk property operations.
+// An empty filter selects all issues the user has permission to edit.
+type IssuePropertyFilter struct {
+	// EntityIDs are the IDs of the issues.
+	EntityIDs []int `json:"entityIds,omitempty"`
+	// CurrentValue only selects the issues having the property with this value.
+	CurrentValue interface{} `json:"currentValue,omitempty"`
+	// HasProperty only selects the issues having (true) or not having (false) the property.
+	// It is ignored by BulkDeleteIssueProperty.
+	HasProperty *bool `json:"hasProperty,omitempty"`
+}
+
+// propertyValue is the EntityProperty with the undecoded value, used by GetInto.
+type propertyValue struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+// propertyEndpoint returns the API endpoint of the property with the given key,
+// or of all properties if propertyKey is empty.
+func propertyEndpoint(entity PropertyEntity, entityID, propertyKey string) (string, error) {
+	var apiEndpoint string
+	switch entity {
+	case PropertyEntityIssue, PropertyEntityProject, PropertyEntityComment:
+		apiEndpoint = fmt.Sprintf("rest/api/2/%s/%s/properties", entity, url.PathEscape(entityID))
+	case PropertyEntityBoard, PropertyEntitySprint:
+		apiEndpoint = fmt.Sprintf("rest/agile/1.0/%s/%s/properties", entity, url.PathEscape(entityID))
+	case PropertyEntityUser:
+		apiEndpoint = "rest/api/2/user/properties"
+	default:
+		return "", fmt.Errorf("unsupported property entity %q", entity)
+	}
+
+	if propertyKey != "" {
+		apiEndpoint += "/" + url.PathEscape(propertyKey)
+	}
+	if entity == PropertyEntityUser {
+		apiEndpoint += "?accountId=" + url.QueryEscape(entityID)
+	}
+	return apiEndpoint, nil
+}
+
+// Keys returns the keys of all properties of an entity.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-properties/#api-rest-api-2-issue-issueidorkey-properties-get
+func (s *PropertyService) Keys(ctx context.Context, entity PropertyEntity, entityID string) ([]PropertyKey, *Response, error) {
+	apiEndpoint, err := propertyEndpoint(entity, entityID, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := new(PropertyKeys)
+	resp, err := s.client.Do(req, keys)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return keys.Keys, resp, nil
+}
+
+// Get returns the property of an entity with the given key.
+// The value is decoded into generic JSON types, use GetInto to decode it into a struct.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-properties/#api-rest-api-2-issue-issueidorkey-properties-propertykey-get
+func (s *PropertyService) Get(ctx context.Context, entity PropertyEntity, entityID, propertyKey string) (*EntityProperty, *Response, error) {
+	apiEndpoint, err := propertyEndpoint(entity, entityID, propertyKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(EntityProperty)
+	resp, err := s.client.Do(req, property)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return property, resp, nil
+}
+
+// GetInto decodes the value of the property of an entity with the given key into v.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-properties/#api-rest-api-2-issue-issueidorkey-properties-propertykey-get
+func (s *PropertyService) GetInto(ctx context.Context, entity PropertyEntity, entityID, propertyKey string, v interface{}) (*Response, error) {
+	apiEndpoint, err := propertyEndpoint(entity, entityID, propertyKey)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	property := new(propertyValue)
+	resp, err := s.client.Do(req, property)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	if err := json.Unmarshal(property.Value, v); err != nil {
+		return resp, fmt.Errorf("decoding property %s: %w", propertyKey, err)
+	}
+	return resp, nil
+}
+
+// Set creates or replaces the property of an entity with the given key.
+// value can be anything that is encodable as JSON, e.g. a struct, a map or a json.RawMessage.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-properties/#api-rest-api-2-issue-issueidorkey-properties-propertykey-put
+func (s *PropertyService) Set(ctx context.Context, entity PropertyEntity, entityID, propertyKey string, value interface{}) (*Response, error) {
+	apiEndpoint, err := propertyEndpoint(entity, entityID, propertyKey)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, value)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Delete removes the property of an entity with the given key.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-properties/#api-rest-api-2-issue-issueidorkey-properties-propertykey-delete
+func (s *PropertyService) Delete(ctx context.Context, entity PropertyEntity, entityID, propertyKey string) (*Response, error) {
+	apiEndpoint, err := propertyEndpoint(entity, entityID, propertyKey)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// BulkSetIssueProperty sets the property with the given key on all issues selected by filter
+// and returns the task doing so. Use TaskService.Wait to wait for it to finish.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-properties/#api-rest-api-2-issue-properties-propertykey-put
+func (s *PropertyService) BulkSetIssueProperty(ctx context.Context, propertyKey string, value interface{}, filter *IssuePropertyFilter) (*Task, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/properties/%s", url.PathEscape(propertyKey))
+	body := struct {
+		Value  interface{}          `json:"value"`
+		Filter *IssuePropertyFilter `json:"filter,omitempty"`
+	}{value, filter}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Jira answers with 303 See Other to the task, which is followed by the HTTP client
+	task := new(Task)
+	resp, err := s.client.Do(req, task)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return task, resp, nil
+}
+
+// BulkDeleteIssueProperty removes the property with the given key from all issues selected by filter
+// and returns the task doing so. Use TaskService.Wait to wait for it to finish.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-properties/#api-rest-api-2-issue-properties-propertykey-delete
+func (s *PropertyService) BulkDeleteIssueProperty(ctx context.Context, propertyKey string, filter *IssuePropertyFilter) (*Task, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/properties/%s", url.PathEscape(propertyKey))
+	body := struct {
+		EntityIDs    []int       `json:"entityIds,omitempty"`
+		CurrentValue interface{} `json:"currentValue,omitempty"`
+	}{}
+	if filter != nil {
+		body.EntityIDs = filter.EntityIDs
+		body.CurrentValue = filter.CurrentValue
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Jira answers with 303 See Other to the task, which is followed by the HTTP client
+	task := new(Task)
+	resp, err := s.client.Do(req, task)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return task, resp, nil
+}
+
+// SetIssuePropertyByJQL sets the property with the given key on all issues matching jql
+// and returns the task doing so. Use TaskService.Wait to wait for it to finish.
+// If no issue matches, nothing is changed and the task is nil.
+func (s *PropertyService) SetIssuePropertyByJQL(ctx context.Context, propertyKey string, value interface{}, jql string) (*Task, *Response, error) {
+	ids, err := s.issueIDs(ctx, jql)
+	if err != nil || len(ids) == 0 {
+		return nil, nil, err
+	}
+	return s.BulkSetIssueProperty(ctx, propertyKey, value, &IssuePropertyFilter{EntityIDs: ids})
+}
+
+// DeleteIssuePropertyByJQL removes the property with the given key from all issues matching jql
+// and returns the task doing so. Use TaskService.Wait to wait for it to finish.
+// If no issue matches, nothing is changed and the task is nil.
+func (s *PropertyService) DeleteIssuePropertyByJQL(ctx context.Context, propertyKey, jql string) (*Task, *Response, error) {
+	ids, err := s.issueIDs(ctx, jql)
+	if err != nil || len(ids) == 0 {
+		return nil, nil, err
+	}
+	return s.BulkDeleteIssueProperty(ctx, propertyKey, &IssuePropertyFilter{EntityIDs: ids})
+}
+
+// issueIDs returns the IDs of all issues matching jql.
+// The bulk endpoints only filter by issue IDs, and an empty filter would select all issues.
+func (s *PropertyService) issueIDs(ctx context.Context, jql string) ([]int, error) {
+	if jql == "" {
+		return nil, errors.New("jql must not be empty")
+	}
+
+	var ids []int
+	err := s.client.Issue.SearchPages(ctx, jql, &SearchOptions{MaxResults: 100, Fields: []string{"id"}}, func(i Issue) error {
+		id, err := strconv.Atoi(i.ID)
+		if err != nil {
+			return fmt.Errorf("invalid issue ID %q of %s", i.ID, i.Key)
+		}
+		ids = append(ids, id)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
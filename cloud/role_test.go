@@ -2,9 +2,11 @@ package cloud
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
+	"reflect"
 	"testing"
 )
 
@@ -106,3 +108,209 @@ func TestRoleService_Get(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestRoleService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/3/role", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["name"] != "Developers" || body["description"] != "A project role that represents developers in a project" {
+			t.Errorf("Unexpected request body: %v", body)
+		}
+		fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/3/role/10360","name":"Developers","id":10360,"description":"A project role that represents developers in a project","actors":[]}`)
+	})
+
+	role, _, err := testClient.Role.Create(context.Background(), &RoleCreateOptions{
+		Name:        "Developers",
+		Description: "A project role that represents developers in a project",
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if role.ID != 10360 {
+		t.Errorf("Unexpected role: %+v", role)
+	}
+}
+
+func TestRoleService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/3/role/10360", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body) != 1 || body["description"] != "Changed" {
+			t.Errorf("Unexpected request body: %v", body)
+		}
+		fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/3/role/10360","name":"Developers","id":10360,"description":"Changed"}`)
+	})
+
+	role, _, err := testClient.Role.Update(context.Background(), 10360, &RoleCreateOptions{Description: "Changed"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if role.Description != "Changed" {
+		t.Errorf("Unexpected role: %+v", role)
+	}
+}
+
+func TestRoleService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/3/role/10360", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, "/rest/api/3/role/10360?swap=10002")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Role.Delete(context.Background(), 10360, &RoleDeleteOptions{Swap: 10002}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestRoleService_GetProjectRoles(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/3/project/EX/roledetails", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"self":"https://your-domain.atlassian.net/rest/api/3/project/EX/role/10002","name":"Administrators","id":10002,"description":"A project role that represents administrators in a project"}]`)
+	})
+
+	roles, _, err := testClient.Role.GetProjectRoles(context.Background(), "EX")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(roles) != 1 || roles[0].ID != 10002 || roles[0].Name != "Administrators" {
+		t.Errorf("Unexpected roles: %+v", roles)
+	}
+}
+
+func TestRoleService_GetProjectRole(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/3/project/EX/role/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/3/project/EX/role/10002","name":"Administrators","id":10002,"actors":[
+			{"id":10240,"displayName":"jira-developers","type":"atlassian-group-role-actor","name":"jira-developers","actorGroup":{"name":"jira-developers","displayName":"jira-developers","groupId":"952d12c3-5b5b-4d04-bb32-44d383afc4b2"}},
+			{"id":10241,"displayName":"Mia Krystof","type":"atlassian-user-role-actor","actorUser":{"accountId":"5b10a2844c20165700ede21g"}}
+		]}`)
+	})
+
+	role, _, err := testClient.Role.GetProjectRole(context.Background(), "EX", 10002)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(role.Actors) != 2 {
+		t.Fatalf("Expected 2 actors, got %d", len(role.Actors))
+	}
+	if role.Actors[0].Type != ActorTypeGroup || role.Actors[0].ActorGroup.GroupID != "952d12c3-5b5b-4d04-bb32-44d383afc4b2" {
+		t.Errorf("Unexpected group actor: %+v", role.Actors[0])
+	}
+	if role.Actors[1].Type != ActorTypeUser || role.Actors[1].ActorUser.AccountID != "5b10a2844c20165700ede21g" {
+		t.Errorf("Unexpected user actor: %+v", role.Actors[1])
+	}
+}
+
+func TestRoleService_AddProjectActors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/3/project/EX/role/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body map[string][]string
+		json.NewDecoder(r.Body).Decode(&body)
+		if !reflect.DeepEqual(body, map[string][]string{"user": {"USER1"}, "group": {"jira-developers"}}) {
+			t.Errorf("Unexpected request body: %v", body)
+		}
+		fmt.Fprint(w, `{"name":"Administrators","id":10002,"actors":[{"id":10240,"type":"atlassian-group-role-actor","name":"jira-developers"}]}`)
+	})
+
+	role, _, err := testClient.Role.AddProjectActors(context.Background(), "EX", 10002, &RoleActors{Users: []string{"USER1"}, Groups: []string{"jira-developers"}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(role.Actors) != 1 {
+		t.Errorf("Unexpected role: %+v", role)
+	}
+}
+
+func TestRoleService_SetProjectActors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/3/project/EX/role/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		var body struct {
+			ID                int                 `json:"id"`
+			CategorisedActors map[string][]string `json:"categorisedActors"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		want := map[string][]string{ActorTypeUser: {"USER1"}, ActorTypeGroup: {}, ActorTypeGroupID: {}}
+		if body.ID != 10002 || !reflect.DeepEqual(body.CategorisedActors, want) {
+			t.Errorf("Unexpected request body: %+v", body)
+		}
+		fmt.Fprint(w, `{"name":"Administrators","id":10002}`)
+	})
+
+	if _, _, err := testClient.Role.SetProjectActors(context.Background(), "EX", 10002, &RoleActors{Users: []string{"USER1"}}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestRoleService_RemoveProjectActor(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/3/project/EX/role/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, "/rest/api/3/project/EX/role/10002?user=USER1")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Role.RemoveProjectActor(context.Background(), "EX", 10002, &RoleActorOptions{User: "USER1"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestRoleService_DefaultActors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/3/role/10002/actors", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			var body map[string][]string
+			json.NewDecoder(r.Body).Decode(&body)
+			if !reflect.DeepEqual(body, map[string][]string{"group": {"jira-administrators"}}) {
+				t.Errorf("Unexpected request body: %v", body)
+			}
+		case http.MethodDelete:
+			testRequestURL(t, r, "/rest/api/3/role/10002/actors?group=jira-administrators")
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+		fmt.Fprint(w, `{"actors":[{"id":10240,"type":"atlassian-group-role-actor","name":"jira-administrators"}]}`)
+	})
+
+	role, _, err := testClient.Role.GetDefaultActors(context.Background(), 10002)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(role.Actors) != 1 || role.Actors[0].Name != "jira-administrators" {
+		t.Errorf("Unexpected default actors: %+v", role.Actors)
+	}
+	if _, _, err := testClient.Role.AddDefaultActors(context.Background(), 10002, &RoleActors{Groups: []string{"jira-administrators"}}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Role.RemoveDefaultActor(context.Background(), 10002, &RoleActorOptions{Group: "jira-administrators"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
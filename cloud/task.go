@@ -0,0 +1,107 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// TaskService handles long-running asynchronous tasks for the Jira instance / API,
+// e.g. the deletion of a project or setting issue properties in bulk.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-tasks/
+type TaskService service
+
+// Task statuses
+const (
+	TaskStatusEnqueued        = "ENQUEUED"
+	TaskStatusRunning         = "RUNNING"
+	TaskStatusComplete        = "COMPLETE"
+	TaskStatusFailed          = "FAILED"
+	TaskStatusCancelRequested = "CANCEL_REQUESTED"
+	TaskStatusCancelled       = "CANCELLED"
+	TaskStatusDead            = "DEAD"
+)
+
+// Task represents a long-running asynchronous task.
+// The times are in milliseconds since the Unix epoch.
+type Task struct {
+	Self           string          `json:"self" structs:"self"`
+	ID             string          `json:"id" structs:"id"`
+	Description    string          `json:"description,omitempty" structs:"description,omitempty"`
+	Status         string          `json:"status" structs:"status"`
+	Message        string          `json:"message,omitempty" structs:"message,omitempty"`
+	Result         json.RawMessage `json:"result,omitempty" structs:"result,omitempty"`
+	SubmittedBy    int64           `json:"submittedBy" structs:"submittedBy"`
+	Progress       int64           `json:"progress" structs:"progress"`
+	ElapsedRuntime int64           `json:"elapsedRuntime" structs:"elapsedRuntime"`
+	Submitted      int64           `json:"submitted" structs:"submitted"`
+	Started        int64           `json:"started,omitempty" structs:"started,omitempty"`
+	Finished       int64           `json:"finished,omitempty" structs:"finished,omitempty"`
+	LastUpdate     int64           `json:"lastUpdate" structs:"lastUpdate"`
+}
+
+// Done reports whether the task finished, successfully or not.
+func (t *Task) Done() bool {
+	switch t.Status {
+	case TaskStatusComplete, TaskStatusFailed, TaskStatusCancelled, TaskStatusDead:
+		return true
+	}
+	return false
+}
+
+// Get returns the status of a task.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-tasks/#api-rest-api-2-task-taskid-get
+func (s *TaskService) Get(ctx context.Context, taskID string) (*Task, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/task/%s", taskID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(Task)
+	resp, err := s.client.Do(req, task)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return task, resp, nil
+}
+
+// Cancel requests the cancellation of a task.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-tasks/#api-rest-api-2-task-taskid-cancel-post
+func (s *TaskService) Cancel(ctx context.Context, taskID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/task/%s/cancel", taskID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Wait polls the task every interval until it is done or ctx is done.
+// It returns the finished task, which may have failed: check Task.Status.
+func (s *TaskService) Wait(ctx context.Context, taskID string, interval time.Duration) (*Task, *Response, error) {
+	for {
+		task, resp, err := s.Get(ctx, taskID)
+		if err != nil || task.Done() {
+			return task, resp, err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return task, resp, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTaskService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/task/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/2/task/1","id":"1","description":"Deleting project EX","status":"COMPLETE","result":"the task result","submittedBy":10000,"progress":100,"elapsedRuntime":156,"submitted":1501708132000,"started":1501708132000,"finished":1501708132000,"lastUpdate":1501708132000}`)
+	})
+
+	task, _, err := testClient.Task.Get(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if task.Status != TaskStatusComplete || task.Progress != 100 || string(task.Result) != `"the task result"` {
+		t.Errorf("Unexpected task: %+v", task)
+	}
+	if !task.Done() {
+		t.Error("Expected a complete task to be done")
+	}
+}
+
+func TestTaskService_Cancel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/task/1/cancel", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	if _, err := testClient.Task.Cancel(context.Background(), "1"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestTaskService_Wait(t *testing.T) {
+	setup()
+	defer teardown()
+
+	polls := 0
+	testMux.HandleFunc("/rest/api/2/task/1", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status := TaskStatusRunning
+		if polls == 3 {
+			status = TaskStatusFailed
+		}
+		fmt.Fprintf(w, `{"id":"1","status":%q}`, status)
+	})
+
+	task, _, err := testClient.Task.Wait(context.Background(), "1", time.Millisecond)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if task.Status != TaskStatusFailed || polls != 3 {
+		t.Errorf("Expected the failed task after 3 polls, got %+v after %d polls", task, polls)
+	}
+}
@@ -0,0 +1,124 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/andygrunwald/go-jira/v2/internal/core"
+)
+
+// TimeTrackingService handles the time tracking configuration for the Jira instance / API.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-time-tracking/
+type TimeTrackingService service
+
+// TimeTrackingConfiguration is the working time configuration of the time tracking.
+// It defines the length of a day ("d") and of a week ("w") in Jira durations.
+type TimeTrackingConfiguration struct {
+	WorkingHoursPerDay float64 `json:"workingHoursPerDay" structs:"workingHoursPerDay"`
+	WorkingDaysPerWeek float64 `json:"workingDaysPerWeek" structs:"workingDaysPerWeek"`
+	// TimeFormat is the format durations are displayed in: "pretty", "days" or "hours".
+	TimeFormat string `json:"timeFormat,omitempty" structs:"timeFormat,omitempty"`
+	// DefaultUnit of durations without a unit: "minute", "hour", "day" or "week".
+	DefaultUnit string `json:"defaultUnit,omitempty" structs:"defaultUnit,omitempty"`
+}
+
+// DefaultTimeTrackingConfiguration is the default working time of Jira: 8 hours a day, 5 days a week.
+var DefaultTimeTrackingConfiguration = TimeTrackingConfiguration{
+	WorkingHoursPerDay: 8,
+	WorkingDaysPerWeek: 5,
+	TimeFormat:         "pretty",
+	DefaultUnit:        "minute",
+}
+
+// GetConfiguration returns the working time configuration of the time tracking.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-time-tracking/#api-rest-api-2-configuration-timetracking-options-get
+func (s *TimeTrackingService) GetConfiguration(ctx context.Context) (*TimeTrackingConfiguration, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "rest/api/2/configuration/timetracking/options", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	config := new(TimeTrackingConfiguration)
+	resp, err := s.client.Do(req, config)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return config, resp, nil
+}
+
+func (c *TimeTrackingConfiguration) workingTime() core.WorkingTime {
+	return core.WorkingTime{HoursPerDay: c.WorkingHoursPerDay, DaysPerWeek: c.WorkingDaysPerWeek, DefaultUnit: c.DefaultUnit}
+}
+
+// ParseDuration parses a Jira duration like "1w 2d 3h 30m" with the working time of c.
+func (c *TimeTrackingConfiguration) ParseDuration(s string) (Duration, error) {
+	d, err := core.ParseDuration(s, c.workingTime())
+	return Duration(d), err
+}
+
+// FormatDuration formats d as Jira duration like "1w 2d 3h 30m" with the working time of c.
+func (c *TimeTrackingConfiguration) FormatDuration(d Duration) string {
+	return core.FormatDuration(time.Duration(d), c.workingTime())
+}
+
+// Duration is an amount of working time, like an estimate or the time spent of a worklog.
+//
+// Days and weeks of Jira durations depend on the TimeTrackingConfiguration,
+// so Duration is formatted in hours and minutes, e.g. "59h 30m", which is the same on every instance.
+// This makes it usable for the estimates of TimeTracking and the TimeSpent of a WorklogRecord:
+//
+//	d, err := config.ParseDuration("1w 2d")
+//	record := &WorklogRecord{TimeSpent: d.String()}
+type Duration time.Duration
+
+// ParseDuration parses a Jira duration like "1w 2d 3h 30m" with the DefaultTimeTrackingConfiguration.
+// Use TimeTrackingConfiguration.ParseDuration for instances with another working time.
+func ParseDuration(s string) (Duration, error) {
+	return DefaultTimeTrackingConfiguration.ParseDuration(s)
+}
+
+// String formats d in hours and minutes, e.g. "59h 30m".
+func (d Duration) String() string {
+	return core.FormatHoursMinutes(time.Duration(d))
+}
+
+// Seconds returns d in seconds, like the *Seconds fields of TimeTracking and WorklogRecord.
+func (d Duration) Seconds() int {
+	return int(time.Duration(d) / time.Second)
+}
+
+// MarshalJSON encodes d as string in hours and minutes, e.g. "59h 30m".
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON decodes a number of seconds or a Jira duration string in hours and minutes, e.g. "59h 30m".
+// Strings with days or weeks are rejected, as their length depends on the working time of the instance.
+// Parse those with TimeTrackingConfiguration.ParseDuration.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	// Ignore null, like in the main JSON package.
+	if string(b) == "null" {
+		return nil
+	}
+
+	var seconds int
+	if err := json.Unmarshal(b, &seconds); err == nil {
+		*d = Duration(time.Duration(seconds) * time.Second)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("invalid duration %s", b)
+	}
+	parsed, err := core.ParseHoursMinutes(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
@@ -0,0 +1,78 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTimeTrackingService_GetConfiguration(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/configuration/timetracking/options", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"workingHoursPerDay":7.5,"workingDaysPerWeek":4,"timeFormat":"pretty","defaultUnit":"hour"}`)
+	})
+
+	config, _, err := testClient.TimeTracking.GetConfiguration(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if config.WorkingHoursPerDay != 7.5 || config.WorkingDaysPerWeek != 4 || config.DefaultUnit != "hour" {
+		t.Errorf("Unexpected configuration: %+v", config)
+	}
+
+	d, err := config.ParseDuration("1w 1d 2")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if want := Duration(39*time.Hour + 30*time.Minute); d != want {
+		t.Errorf("ParseDuration = %s, want %s", d, want)
+	}
+	if got := config.FormatDuration(d); got != "1w 1d 2h" {
+		t.Errorf("FormatDuration = %q, want %q", got, "1w 1d 2h")
+	}
+}
+
+func TestDuration(t *testing.T) {
+	d, err := ParseDuration("1w 2d 3h 30m")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if d.String() != "59h 30m" || d.Seconds() != 214200 {
+		t.Errorf("Unexpected duration %s (%d seconds)", d, d.Seconds())
+	}
+	if got := DefaultTimeTrackingConfiguration.FormatDuration(d); got != "1w 2d 3h 30m" {
+		t.Errorf("FormatDuration = %q", got)
+	}
+}
+
+func TestDuration_JSON(t *testing.T) {
+	payload := struct {
+		TimeSpent Duration `json:"timeSpent"`
+	}{Duration(90 * time.Minute)}
+	b, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got, want := string(b), `{"timeSpent":"1h 30m"}`; got != want {
+		t.Errorf("Marshal = %s, want %s", got, want)
+	}
+
+	var values []Duration
+	if err := json.Unmarshal([]byte(`["8h 30m", 5400, null]`), &values); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if values[0] != Duration(8*time.Hour+30*time.Minute) || values[1] != Duration(90*time.Minute) || values[2] != 0 {
+		t.Errorf("Unexpected durations %v", values)
+	}
+
+	for _, invalid := range []string{`"1x"`, `"1d"`, `"2w 3h"`} {
+		if err := json.Unmarshal([]byte(invalid), &values[0]); err == nil {
+			t.Errorf("Expected an error for the duration %s", invalid)
+		}
+	}
+}
@@ -5,6 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/andygrunwald/go-jira/v2/internal/core"
 )
 
 // UserService handles users for the Jira instance / API.
@@ -76,6 +80,46 @@ type UserSearch []UserSearchParam
 
 type UserSearchF func(UserSearch) UserSearch
 
+// encode returns the parameters as escaped query string.
+func (s UserSearch) encode() string {
+	values := url.Values{}
+	for _, param := range s {
+		values.Add(param.name, param.value)
+	}
+	return values.Encode()
+}
+
+// UserSuggestion is a user found by UserService.FindForPicker.
+type UserSuggestion struct {
+	AccountID   string `json:"accountId,omitempty" structs:"accountId,omitempty"`
+	AccountType string `json:"accountType,omitempty" structs:"accountType,omitempty"`
+	DisplayName string `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	AvatarURL   string `json:"avatarUrl,omitempty" structs:"avatarUrl,omitempty"`
+	// HTML is the display name with the matching part highlighted, e.g. "<strong>Mi</strong>a Krystof".
+	HTML string `json:"html,omitempty" structs:"html,omitempty"`
+}
+
+// UserMigration maps the username and user key of a user to the account ID.
+type UserMigration struct {
+	Username  string `json:"username,omitempty" structs:"username,omitempty"`
+	Key       string `json:"key,omitempty" structs:"key,omitempty"`
+	AccountID string `json:"accountId,omitempty" structs:"accountId,omitempty"`
+}
+
+// userBulkResult is only a small wrapper around the BulkGet method
+// to be able to parse the results
+type userBulkResult struct {
+	StartAt    int    `json:"startAt"`
+	MaxResults int    `json:"maxResults"`
+	Total      int    `json:"total"`
+	IsLast     bool   `json:"isLast"`
+	Values     []User `json:"values"`
+}
+
+func (r *userBulkResult) Page() core.Page {
+	return core.Page{StartAt: r.StartAt, MaxResults: r.MaxResults, Total: r.Total, IsLast: r.IsLast}
+}
+
 // Get gets user info from Jira using its Account Id
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-user-get
@@ -265,6 +309,32 @@ func WithProperty(property string) UserSearchF {
 	}
 }
 
+// WithProject restricts the search to users of a project (FindAssignable and FindWithPermissions)
+func WithProject(projectKey string) UserSearchF {
+	return func(s UserSearch) UserSearch {
+		s = append(s, UserSearchParam{name: "project", value: projectKey})
+		return s
+	}
+}
+
+// WithIssueKey restricts the search to users of an issue (FindAssignable and FindWithPermissions)
+func WithIssueKey(issueKey string) UserSearchF {
+	return func(s UserSearch) UserSearch {
+		s = append(s, UserSearchParam{name: "issueKey", value: issueKey})
+		return s
+	}
+}
+
+// WithExclude excludes users by their account ID (FindForPicker)
+func WithExclude(accountIDs ...string) UserSearchF {
+	return func(s UserSearch) UserSearch {
+		for _, id := range accountIDs {
+			s = append(s, UserSearchParam{name: "excludeAccountIds", value: id})
+		}
+		return s
+	}
+}
+
 // Find searches for user info from Jira:
 // It can find users by email or display name using the query parameter
 //
@@ -301,3 +371,132 @@ func (s *UserService) Find(ctx context.Context, property string, tweaks ...UserS
 	}
 	return users, resp, nil
 }
+
+// FindAssignable returns the users matching query that can be assigned to issues
+// of the project (WithProject) or to the issue (WithIssueKey).
+// One of them is required.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-user-search/#api-rest-api-2-user-assignable-search-get
+func (s *UserService) FindAssignable(ctx context.Context, query string, tweaks ...UserSearchF) ([]User, *Response, error) {
+	search := UserSearch{{name: "query", value: query}}
+	for _, f := range tweaks {
+		search = f(search)
+	}
+	return s.findUsers(ctx, "rest/api/2/user/assignable/search", search)
+}
+
+// FindWithPermissions returns the users matching query that have all permissions,
+// e.g. "BROWSE_PROJECTS" or "EDIT_ISSUES", globally, in the project (WithProject) or for the issue (WithIssueKey).
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-user-search/#api-rest-api-2-user-permission-search-get
+func (s *UserService) FindWithPermissions(ctx context.Context, query string, permissions []string, tweaks ...UserSearchF) ([]User, *Response, error) {
+	search := UserSearch{{name: "query", value: query}, {name: "permissions", value: strings.Join(permissions, ",")}}
+	for _, f := range tweaks {
+		search = f(search)
+	}
+	// This endpoint names the project parameter projectKey
+	for i := range search {
+		if search[i].name == "project" {
+			search[i].name = "projectKey"
+		}
+	}
+	return s.findUsers(ctx, "rest/api/2/user/permission/search", search)
+}
+
+func (s *UserService) findUsers(ctx context.Context, endpoint string, search UserSearch) ([]User, *Response, error) {
+	apiEndpoint := endpoint + "?" + search.encode()
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	users := []User{}
+	resp, err := s.client.Do(req, &users)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return users, resp, nil
+}
+
+// FindForPicker returns the users matching query, as shown in the user picker.
+// The number of matching users is set as Response.Total.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-user-search/#api-rest-api-2-user-picker-get
+func (s *UserService) FindForPicker(ctx context.Context, query string, tweaks ...UserSearchF) ([]UserSuggestion, *Response, error) {
+	search := UserSearch{{name: "query", value: query}}
+	for _, f := range tweaks {
+		search = f(search)
+	}
+
+	apiEndpoint := "rest/api/2/user/picker?" + search.encode()
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := struct {
+		Total int              `json:"total"`
+		Users []UserSuggestion `json:"users"`
+	}{}
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	resp.Total = result.Total
+	return result.Users, resp, nil
+}
+
+// BulkGet returns a page of the users with the given account IDs.
+// The paging values are set in the Response, use WithStartAt and WithMaxResults to select the page.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-users/#api-rest-api-2-user-bulk-get
+func (s *UserService) BulkGet(ctx context.Context, accountIDs []string, tweaks ...UserSearchF) ([]User, *Response, error) {
+	search := make(UserSearch, 0, len(accountIDs))
+	for _, id := range accountIDs {
+		search = append(search, UserSearchParam{name: "accountId", value: id})
+	}
+	for _, f := range tweaks {
+		search = f(search)
+	}
+
+	apiEndpoint := "rest/api/2/user/bulk?" + search.encode()
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(userBulkResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Values, resp, nil
+}
+
+// GetAccountIDs returns the account IDs of the users with the given usernames.
+// This is meant to migrate data that still identifies users by their username (see the GDPR changes of Jira Cloud).
+// Unknown usernames are left out of the result.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-users/#api-rest-api-2-user-bulk-migration-get
+func (s *UserService) GetAccountIDs(ctx context.Context, usernames []string, tweaks ...UserSearchF) ([]UserMigration, *Response, error) {
+	search := make(UserSearch, 0, len(usernames))
+	for _, name := range usernames {
+		search = append(search, UserSearchParam{name: "username", value: name})
+	}
+	for _, f := range tweaks {
+		search = f(search)
+	}
+
+	apiEndpoint := "rest/api/2/user/bulk/migration?" + search.encode()
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	migrations := []UserMigration{}
+	resp, err := s.client.Do(req, &migrations)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return migrations, resp, nil
+}
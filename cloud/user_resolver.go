@@ -0,0 +1,245 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/trivago/tgo/tcontainer"
+)
+
+// ErrUserNotFound is returned by the UserResolver if no user matches a username or email address.
+var ErrUserNotFound = errors.New("user not found")
+
+// accountIDPattern matches the formats of account IDs,
+// e.g. "5b10ac8d82e05b22cc7d4ef5" or "557058:f58131cb-b67d-43c7-b30d-6b58d40bd077".
+var accountIDPattern = regexp.MustCompile(`^([0-9][0-9a-z]{23}|[0-9a-z]+:[0-9a-f-]{36}(:[0-9a-f]+)?)$`)
+
+// UserResolver converts usernames and email addresses to account IDs.
+//
+// Since the GDPR changes Jira Cloud only identifies users by their account ID
+// and rejects payloads like User{Name: "fred"}, which Jira Server / Data Center expects.
+// If a UserResolver is set as Client.UserResolver, the users passed to
+// IssueService.Create, Update, UpdateIssue, UpdateAssignee, AddWatcher, RemoveWatcher and Notify
+// and GroupService.AddUserByGroupName, RemoveUserByGroupName, AddUserByGroupID and RemoveUserByGroupID
+// are converted before they are sent, so that the same calling code works with both products:
+//
+//	client.UserResolver = cloud.NewUserResolver(client)
+//	client.Issue.AddWatcher(ctx, "EX-1", "fred") // adds the account ID of fred
+//
+// Usernames are looked up with UserService.GetAccountIDs and email addresses with UserService.Find.
+// Email addresses only resolve to users whose email address is visible to the client.
+// Values that already are account IDs are passed through.
+// Resolved account IDs are cached for the life of the UserResolver.
+type UserResolver struct {
+	client *Client
+
+	mu    sync.Mutex
+	cache map[string]string
+}
+
+// NewUserResolver returns a UserResolver looking up users via client.
+func NewUserResolver(client *Client) *UserResolver {
+	return &UserResolver{client: client, cache: make(map[string]string)}
+}
+
+// AccountID returns the account ID of the user identified by user,
+// which is a username, an email address or an account ID.
+func (r *UserResolver) AccountID(ctx context.Context, user string) (string, error) {
+	if user == "" || accountIDPattern.MatchString(user) {
+		return user, nil
+	}
+
+	r.mu.Lock()
+	accountID, ok := r.cache[user]
+	r.mu.Unlock()
+	if ok {
+		return accountID, nil
+	}
+
+	var err error
+	if strings.Contains(user, "@") {
+		accountID, err = r.byEmailAddress(ctx, user)
+	} else {
+		accountID, err = r.byUsername(ctx, user)
+	}
+	if err != nil {
+		return "", fmt.Errorf("resolving user %q: %w", user, err)
+	}
+
+	r.mu.Lock()
+	r.cache[user] = accountID
+	r.mu.Unlock()
+	return accountID, nil
+}
+
+// Reset clears the cache.
+func (r *UserResolver) Reset() {
+	r.mu.Lock()
+	r.cache = make(map[string]string)
+	r.mu.Unlock()
+}
+
+func (r *UserResolver) byUsername(ctx context.Context, username string) (string, error) {
+	migrations, _, err := r.client.User.GetAccountIDs(ctx, []string{username})
+	if err != nil {
+		return "", err
+	}
+	for _, m := range migrations {
+		if strings.EqualFold(m.Username, username) && m.AccountID != "" {
+			return m.AccountID, nil
+		}
+	}
+	return "", ErrUserNotFound
+}
+
+func (r *UserResolver) byEmailAddress(ctx context.Context, email string) (string, error) {
+	users, _, err := r.client.User.Find(ctx, url.QueryEscape(email))
+	if err != nil {
+		return "", err
+	}
+	hidden := false
+	for _, u := range users {
+		if strings.EqualFold(u.EmailAddress, email) {
+			return u.AccountID, nil
+		}
+		hidden = hidden || u.EmailAddress == ""
+	}
+	// The search matches prefixes of names and email addresses, e.g. fred@example.com.au for fred@example.com,
+	// so a user whose email address is hidden by the privacy settings can't be told apart from another user.
+	if hidden {
+		return "", fmt.Errorf("%w: the email address of the matching users is hidden", ErrUserNotFound)
+	}
+	return "", ErrUserNotFound
+}
+
+// ResolveUser returns a copy of u identified by its account ID.
+// If the AccountID is empty it is resolved from the Name or EmailAddress,
+// and the Name and Key (which Jira Cloud rejects) are removed.
+func (r *UserResolver) ResolveUser(ctx context.Context, u *User) (*User, error) {
+	if u == nil || u.AccountID != "" {
+		return u, nil
+	}
+	user := u.Name
+	if user == "" {
+		user = u.EmailAddress
+	}
+	if user == "" {
+		return u, nil
+	}
+
+	accountID, err := r.AccountID(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	resolved := *u
+	resolved.AccountID = accountID
+	resolved.Name = ""
+	resolved.Key = ""
+	return &resolved, nil
+}
+
+// ResolveIssue returns a copy of issue with all users of its fields resolved by ResolveUser,
+// including the users of the custom fields (Unknowns), like the ones set by InitIssueWithMetaAndFields.
+func (r *UserResolver) ResolveIssue(ctx context.Context, issue *Issue) (*Issue, error) {
+	if issue == nil || issue.Fields == nil {
+		return issue, nil
+	}
+	fields := *issue.Fields
+
+	var err error
+	if fields.Assignee, err = r.ResolveUser(ctx, fields.Assignee); err != nil {
+		return nil, err
+	}
+	if fields.Reporter, err = r.ResolveUser(ctx, fields.Reporter); err != nil {
+		return nil, err
+	}
+	if fields.Unknowns != nil {
+		unknowns, err := r.resolveValue(ctx, "", fields.Unknowns)
+		if err != nil {
+			return nil, err
+		}
+		fields.Unknowns = unknowns.(tcontainer.MarshalMap)
+	}
+
+	resolved := *issue
+	resolved.Fields = &fields
+	return &resolved, nil
+}
+
+// resolveValue returns a copy of v with all users resolved.
+// Besides User values, untyped user objects like {"name": "fred"} are resolved if they are
+// the value of an assignee or reporter key, as used in the payload of IssueService.UpdateIssue.
+func (r *UserResolver) resolveValue(ctx context.Context, key string, v interface{}) (interface{}, error) {
+	switch t := v.(type) {
+	case User:
+		u, err := r.ResolveUser(ctx, &t)
+		if err != nil {
+			return nil, err
+		}
+		return *u, nil
+	case *User:
+		return r.ResolveUser(ctx, t)
+	case []User:
+		users := make([]User, 0, len(t))
+		for i := range t {
+			u, err := r.ResolveUser(ctx, &t[i])
+			if err != nil {
+				return nil, err
+			}
+			users = append(users, *u)
+		}
+		return users, nil
+	case tcontainer.MarshalMap:
+		m, err := r.resolveMap(ctx, key, t)
+		if err != nil {
+			return nil, err
+		}
+		return tcontainer.MarshalMap(m), nil
+	case map[string]interface{}:
+		return r.resolveMap(ctx, key, t)
+	case []interface{}:
+		values := make([]interface{}, 0, len(t))
+		for _, e := range t {
+			resolved, err := r.resolveValue(ctx, key, e)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, resolved)
+		}
+		return values, nil
+	}
+	return v, nil
+}
+
+func (r *UserResolver) resolveMap(ctx context.Context, key string, m map[string]interface{}) (map[string]interface{}, error) {
+	if key == "assignee" || key == "reporter" {
+		if _, ok := m["accountId"]; !ok {
+			name, _ := m["name"].(string)
+			if name == "" {
+				name, _ = m["emailAddress"].(string)
+			}
+			if name != "" {
+				accountID, err := r.AccountID(ctx, name)
+				if err != nil {
+					return nil, err
+				}
+				return map[string]interface{}{"accountId": accountID}, nil
+			}
+		}
+	}
+
+	resolved := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		value, err := r.resolveValue(ctx, k, v)
+		if err != nil {
+			return nil, err
+		}
+		resolved[k] = value
+	}
+	return resolved, nil
+}
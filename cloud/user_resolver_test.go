@@ -0,0 +1,225 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/trivago/tgo/tcontainer"
+)
+
+// testUserResolverHandlers serves the user lookups of the UserResolver:
+// the username fred and the email address fred@example.com belong to the account ID 5b10a2844c20165700ede21g.
+// The search for ann@example.com only finds ann@example.com.au and the one for hidden@example.com a user with a hidden email address.
+// It returns a pointer to the number of lookups.
+func testUserResolverHandlers(t *testing.T) *int {
+	lookups := 0
+	testMux.HandleFunc("/rest/api/2/user/bulk/migration", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		lookups++
+		if r.URL.Query().Get("username") == "fred" {
+			fmt.Fprint(w, `[{"username":"fred","key":"fred","accountId":"5b10a2844c20165700ede21g"}]`)
+			return
+		}
+		fmt.Fprint(w, `[]`)
+	})
+	testMux.HandleFunc("/rest/api/2/user/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		lookups++
+		switch r.URL.Query().Get("query") {
+		case "fred@example.com":
+			fmt.Fprint(w, `[{"accountId":"5b10a2844c20165700ede21g","displayName":"Fred F. User","emailAddress":"Fred@example.com"}]`)
+		case "ann@example.com":
+			fmt.Fprint(w, `[{"accountId":"5b10a2844c20165700ede21h","displayName":"Ann Other","emailAddress":"ann@example.com.au"}]`)
+		case "hidden@example.com":
+			fmt.Fprint(w, `[{"accountId":"5b10a2844c20165700ede21i","displayName":"Hidden"}]`)
+		default:
+			fmt.Fprint(w, `[]`)
+		}
+	})
+	return &lookups
+}
+
+func TestUserResolver_AccountID(t *testing.T) {
+	setup()
+	defer teardown()
+	lookups := testUserResolverHandlers(t)
+
+	r := NewUserResolver(testClient)
+	for _, user := range []string{"fred", "fred@example.com", "fred", "5b10a2844c20165700ede21g"} {
+		accountID, err := r.AccountID(context.Background(), user)
+		if err != nil {
+			t.Fatalf("Error given for %s: %s", user, err)
+		}
+		if accountID != "5b10a2844c20165700ede21g" {
+			t.Errorf("Account ID of %s = %q, want 5b10a2844c20165700ede21g", user, accountID)
+		}
+	}
+	if *lookups != 2 {
+		t.Errorf("Expected 2 lookups, got %d", *lookups)
+	}
+
+	for _, user := range []string{"unknown", "ann@example.com", "hidden@example.com"} {
+		if _, err := r.AccountID(context.Background(), user); !errors.Is(err, ErrUserNotFound) {
+			t.Errorf("Expected ErrUserNotFound for %s, got %v", user, err)
+		}
+	}
+
+	r.Reset()
+	if _, err := r.AccountID(context.Background(), "fred"); err != nil || *lookups != 6 {
+		t.Errorf("Expected a new lookup after Reset, got %d lookups (error %v)", *lookups, err)
+	}
+}
+
+func TestUserResolver_ResolveIssue(t *testing.T) {
+	setup()
+	defer teardown()
+	testUserResolverHandlers(t)
+
+	issue := &Issue{Fields: &IssueFields{
+		Assignee: &User{Name: "fred"},
+		Reporter: &User{AccountID: "5b10ac8d82e05b22cc7d4ef5"},
+		Unknowns: tcontainer.MarshalMap{
+			"customfield_10000": User{Name: "fred"},
+			"customfield_10001": "fred",
+		},
+	}}
+
+	resolved, err := NewUserResolver(testClient).ResolveIssue(context.Background(), issue)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got := resolved.Fields.Assignee; got.AccountID != "5b10a2844c20165700ede21g" || got.Name != "" {
+		t.Errorf("Unexpected assignee: %+v", got)
+	}
+	if got := resolved.Fields.Reporter; got.AccountID != "5b10ac8d82e05b22cc7d4ef5" {
+		t.Errorf("Unexpected reporter: %+v", got)
+	}
+	if got := resolved.Fields.Unknowns["customfield_10000"].(User); got.AccountID != "5b10a2844c20165700ede21g" {
+		t.Errorf("Unexpected user of the custom field: %+v", got)
+	}
+	if got := resolved.Fields.Unknowns["customfield_10001"]; got != "fred" {
+		t.Errorf("Expected strings to be kept, got %v", got)
+	}
+	if issue.Fields.Assignee.AccountID != "" || issue.Fields.Unknowns["customfield_10000"].(User).AccountID != "" {
+		t.Error("Expected the original issue to be unchanged")
+	}
+}
+
+func TestIssueService_UpdateIssue_UserResolver(t *testing.T) {
+	setup()
+	defer teardown()
+	testUserResolverHandlers(t)
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		var data map[string]map[string]map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Error decoding body: %s", err)
+		}
+		if got := data["fields"]["assignee"]; got["accountId"] != "5b10a2844c20165700ede21g" || got["name"] != "" {
+			t.Errorf("Unexpected assignee: %v", got)
+		}
+		if got := data["fields"]["priority"]; got["name"] != "High" {
+			t.Errorf("Unexpected priority: %v", got)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	testClient.UserResolver = NewUserResolver(testClient)
+	data := map[string]interface{}{"fields": map[string]interface{}{
+		"assignee": map[string]interface{}{"name": "fred"},
+		"priority": map[string]interface{}{"name": "High"},
+	}}
+	if _, err := testClient.Issue.UpdateIssue(context.Background(), "EX-1", data); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_AddWatcher_UserResolver(t *testing.T) {
+	setup()
+	defer teardown()
+	testUserResolverHandlers(t)
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/watchers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var accountID string
+		json.NewDecoder(r.Body).Decode(&accountID)
+		if accountID != "5b10a2844c20165700ede21g" {
+			t.Errorf("Expected the account ID to be sent, got %q", accountID)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	testClient.UserResolver = NewUserResolver(testClient)
+	if _, err := testClient.Issue.AddWatcher(context.Background(), "EX-1", "fred"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_UpdateAssignee_UserResolver(t *testing.T) {
+	setup()
+	defer teardown()
+	testUserResolverHandlers(t)
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/assignee", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		var user User
+		json.NewDecoder(r.Body).Decode(&user)
+		if user.AccountID != "5b10a2844c20165700ede21g" || user.Name != "" {
+			t.Errorf("Unexpected assignee: %+v", user)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	testClient.UserResolver = NewUserResolver(testClient)
+	if _, err := testClient.Issue.UpdateAssignee(context.Background(), "EX-1", &User{EmailAddress: "fred@example.com"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestGroupService_AddUserByGroupName_UserResolver(t *testing.T) {
+	setup()
+	defer teardown()
+	testUserResolverHandlers(t)
+	testMux.HandleFunc("/rest/api/3/group/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var user struct {
+			AccountID string `json:"accountId"`
+		}
+		json.NewDecoder(r.Body).Decode(&user)
+		if user.AccountID != "5b10a2844c20165700ede21g" {
+			t.Errorf("Expected the account ID to be sent, got %q", user.AccountID)
+		}
+		fmt.Fprint(w, `{"name":"default"}`)
+	})
+
+	testClient.UserResolver = NewUserResolver(testClient)
+	if _, _, err := testClient.Group.AddUserByGroupName(context.Background(), "default", "fred"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_Notify_UserResolver(t *testing.T) {
+	setup()
+	defer teardown()
+	testUserResolverHandlers(t)
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/notify", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var notification Notification
+		json.NewDecoder(r.Body).Decode(&notification)
+		if got := notification.To.Users; len(got) != 2 || got[0].AccountID != "5b10a2844c20165700ede21g" || got[1].AccountID != "5b10ac8d82e05b22cc7d4ef5" {
+			t.Errorf("Expected the account IDs to be sent, got %+v", got)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	testClient.UserResolver = NewUserResolver(testClient)
+	notification := &Notification{TextBody: "Outage", To: &NotificationRecipients{Users: []NotificationUser{{EmailAddress: "fred@example.com"}, {AccountID: "5b10ac8d82e05b22cc7d4ef5"}}}}
+	if _, err := testClient.Issue.Notify(context.Background(), "EX-1", notification); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if notification.To.Users[0].AccountID != "" {
+		t.Error("Expected the notification to be unchanged")
+	}
+}
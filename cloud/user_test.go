@@ -282,3 +282,109 @@ func TestUserService_Find_SuccessParams(t *testing.T) {
 		t.Error("Expected user. User is nil")
 	}
 }
+
+func TestUserService_FindAssignable(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/assignable/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"query": "mia k", "project": "EX", "maxResults": "10"})
+		fmt.Fprint(w, `[{"accountId":"5b10a2844c20165700ede21g","displayName":"Mia Krystof","active":true}]`)
+	})
+
+	users, _, err := testClient.User.FindAssignable(context.Background(), "mia k", WithProject("EX"), WithMaxResults(10))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 1 || users[0].AccountID != "5b10a2844c20165700ede21g" {
+		t.Errorf("Unexpected users: %+v", users)
+	}
+}
+
+func TestUserService_FindWithPermissions(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/permission/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"query": "mia", "permissions": "BROWSE_PROJECTS,EDIT_ISSUES", "projectKey": "EX"})
+		fmt.Fprint(w, `[{"accountId":"5b10a2844c20165700ede21g","displayName":"Mia Krystof","active":true}]`)
+	})
+
+	users, _, err := testClient.User.FindWithPermissions(context.Background(), "mia", []string{"BROWSE_PROJECTS", "EDIT_ISSUES"}, WithProject("EX"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 1 || users[0].DisplayName != "Mia Krystof" {
+		t.Errorf("Unexpected users: %+v", users)
+	}
+}
+
+func TestUserService_FindForPicker(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/picker", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got := r.URL.Query()["excludeAccountIds"]; fmt.Sprint(got) != "[5b10a2844c20165700ede21g 5b10ac8d82e05b22cc7d4ef5]" {
+			t.Errorf("Unexpected excludeAccountIds: %v", got)
+		}
+		fmt.Fprint(w, `{"header":"Showing 1 of 1 matching users","total":1,"users":[
+			{"accountId":"5b109f2e9729b51b54dc274d","accountType":"atlassian","html":"<strong>Mi</strong>chael Scofield","displayName":"Michael Scofield"}]}`)
+	})
+
+	users, resp, err := testClient.User.FindForPicker(context.Background(), "mi", WithExclude("5b10a2844c20165700ede21g", "5b10ac8d82e05b22cc7d4ef5"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 1 || users[0].AccountID != "5b109f2e9729b51b54dc274d" || users[0].HTML != "<strong>Mi</strong>chael Scofield" {
+		t.Errorf("Unexpected users: %+v", users)
+	}
+	if resp.Total != 1 {
+		t.Errorf("Expected Total 1, got %d", resp.Total)
+	}
+}
+
+func TestUserService_BulkGet(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/bulk", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got := r.URL.Query()["accountId"]; fmt.Sprint(got) != "[5b10a2844c20165700ede21g 5b10ac8d82e05b22cc7d4ef5]" {
+			t.Errorf("Unexpected accountId parameters: %v", got)
+		}
+		if got := r.URL.Query().Get("startAt"); got != "1" {
+			t.Errorf("Expected startAt 1, got %q", got)
+		}
+		fmt.Fprint(w, `{"maxResults":1,"startAt":1,"total":2,"isLast":true,"values":[{"accountId":"5b10ac8d82e05b22cc7d4ef5","displayName":"Emma Richards"}]}`)
+	})
+
+	users, resp, err := testClient.User.BulkGet(context.Background(), []string{"5b10a2844c20165700ede21g", "5b10ac8d82e05b22cc7d4ef5"}, WithStartAt(1), WithMaxResults(1))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 1 || users[0].DisplayName != "Emma Richards" {
+		t.Errorf("Unexpected users: %+v", users)
+	}
+	if !resp.IsLast || resp.Total != 2 || resp.StartAt != 1 {
+		t.Errorf("Unexpected paging values: %+v", resp)
+	}
+}
+
+func TestUserService_GetAccountIDs(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/bulk/migration", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got := r.URL.Query()["username"]; fmt.Sprint(got) != "[mia emma]" {
+			t.Errorf("Unexpected username parameters: %v", got)
+		}
+		fmt.Fprint(w, `[{"username":"mia","accountId":"5b10a2844c20165700ede21g"},{"username":"emma","accountId":"5b10ac8d82e05b22cc7d4ef5"}]`)
+	})
+
+	migrations, _, err := testClient.User.GetAccountIDs(context.Background(), []string{"mia", "emma"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(migrations) != 2 || migrations[1].Username != "emma" || migrations[1].AccountID != "5b10ac8d82e05b22cc7d4ef5" {
+		t.Errorf("Unexpected migrations: %+v", migrations)
+	}
+}
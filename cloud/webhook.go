@@ -0,0 +1,257 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/andygrunwald/go-jira/v2/internal/core"
+)
+
+// WebhookService handles dynamic webhooks for the Jira instance / API.
+//
+// Dynamic webhooks are registered by OAuth 2.0 and Connect apps and expire after 30 days,
+// unless they are refreshed (see Refresh and AutoRefresh).
+// Webhooks are received with the handler of the package cloud/webhook.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-webhooks/
+type WebhookService service
+
+// Webhook represents a dynamic webhook.
+type Webhook struct {
+	ID int `json:"id,omitempty" structs:"id,omitempty"`
+
+	// JQLFilter selects the issues the webhook is sent for, e.g. "project = EX".
+	// Only a subset of JQL is supported, see the Jira API docs.
+	JQLFilter string `json:"jqlFilter" structs:"jqlFilter"`
+
+	// FieldIDsFilter restricts jira:issue_updated events to changes of these fields.
+	FieldIDsFilter []string `json:"fieldIdsFilter,omitempty" structs:"fieldIdsFilter,omitempty"`
+
+	// IssuePropertyKeysFilter restricts issue_property_* events to these property keys.
+	IssuePropertyKeysFilter []string `json:"issuePropertyKeysFilter,omitempty" structs:"issuePropertyKeysFilter,omitempty"`
+
+	// Events the webhook is sent for, e.g. "jira:issue_created".
+	Events []string `json:"events" structs:"events"`
+
+	// ExpirationDate in milliseconds since the Unix epoch.
+	ExpirationDate int64 `json:"expirationDate,omitempty" structs:"expirationDate,omitempty"`
+}
+
+// Expires returns the ExpirationDate as time.Time.
+func (w *Webhook) Expires() time.Time {
+	return time.UnixMilli(w.ExpirationDate)
+}
+
+// WebhookRegistrationResult is the result of registering a single webhook.
+// Either CreatedWebhookID or Errors is set.
+type WebhookRegistrationResult struct {
+	CreatedWebhookID int      `json:"createdWebhookId,omitempty" structs:"createdWebhookId,omitempty"`
+	Errors           []string `json:"errors,omitempty" structs:"errors,omitempty"`
+}
+
+// WebhookListOptions specifies the optional parameters of WebhookService.GetList.
+type WebhookListOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// FailedWebhook is a webhook that Jira failed to deliver.
+type FailedWebhook struct {
+	ID   string `json:"id" structs:"id"`
+	Body string `json:"body,omitempty" structs:"body,omitempty"`
+	URL  string `json:"url" structs:"url"`
+	// FailureTime in milliseconds since the Unix epoch.
+	FailureTime int64 `json:"failureTime" structs:"failureTime"`
+}
+
+// FailedWebhookOptions specifies the optional parameters of WebhookService.GetFailed.
+type FailedWebhookOptions struct {
+	MaxResults int `url:"maxResults,omitempty"`
+	// After is the cursor of the page, see Response.NextPageToken.
+	After string `url:"after,omitempty"`
+}
+
+// webhookListResult is only a small wrapper around the GetList method
+// to be able to parse the results
+type webhookListResult struct {
+	StartAt    int       `json:"startAt"`
+	MaxResults int       `json:"maxResults"`
+	Total      int       `json:"total"`
+	IsLast     bool      `json:"isLast"`
+	Values     []Webhook `json:"values"`
+}
+
+func (r *webhookListResult) Page() core.Page {
+	return core.Page{StartAt: r.StartAt, MaxResults: r.MaxResults, Total: r.Total, IsLast: r.IsLast}
+}
+
+// failedWebhookResult is only a small wrapper around the GetFailed method
+// to be able to parse the results
+type failedWebhookResult struct {
+	Values     []FailedWebhook `json:"values"`
+	MaxResults int             `json:"maxResults"`
+	Next       string          `json:"next"`
+}
+
+// Page sets the cursor of the next page as NextPageToken.
+// The cursor is the "after" parameter of the next page URL.
+func (r *failedWebhookResult) Page() core.Page {
+	page := core.Page{MaxResults: r.MaxResults, IsLast: r.Next == ""}
+	if u, err := url.Parse(r.Next); err == nil {
+		page.NextPageToken = u.Query().Get("after")
+	}
+	return page
+}
+
+// Register registers webhooks sent to the URL of the app.
+// The results are in the same order as webhooks.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-webhooks/#api-rest-api-2-webhook-post
+func (s *WebhookService) Register(ctx context.Context, webhookURL string, webhooks []Webhook) ([]WebhookRegistrationResult, *Response, error) {
+	apiEndpoint := "rest/api/2/webhook"
+	body := struct {
+		Webhooks []Webhook `json:"webhooks"`
+		URL      string    `json:"url"`
+	}{webhooks, webhookURL}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := struct {
+		Results []WebhookRegistrationResult `json:"webhookRegistrationResult"`
+	}{}
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Results, resp, nil
+}
+
+// GetList returns a page of the webhooks registered by the calling app.
+// The paging values are set in the Response.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-webhooks/#api-rest-api-2-webhook-get
+func (s *WebhookService) GetList(ctx context.Context, options *WebhookListOptions) ([]Webhook, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/webhook", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(webhookListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Values, resp, nil
+}
+
+// Delete removes the webhooks with the given IDs.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-webhooks/#api-rest-api-2-webhook-delete
+func (s *WebhookService) Delete(ctx context.Context, webhookIDs ...int) (*Response, error) {
+	apiEndpoint := "rest/api/2/webhook"
+	body := struct {
+		WebhookIDs []int `json:"webhookIds"`
+	}{webhookIDs}
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Refresh extends the life of the webhooks with the given IDs
+// and returns their new expiration date.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-webhooks/#api-rest-api-2-webhook-refresh-put
+func (s *WebhookService) Refresh(ctx context.Context, webhookIDs ...int) (time.Time, *Response, error) {
+	apiEndpoint := "rest/api/2/webhook/refresh"
+	body := struct {
+		WebhookIDs []int `json:"webhookIds"`
+	}{webhookIDs}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, body)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	result := struct {
+		ExpirationDate int64 `json:"expirationDate"`
+	}{}
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return time.Time{}, resp, NewJiraError(resp, err)
+	}
+	return time.UnixMilli(result.ExpirationDate), resp, nil
+}
+
+// GetFailed returns webhooks that Jira failed to deliver in the last 72 hours.
+// The cursor of the next page is set as Response.NextPageToken
+// and can be passed as FailedWebhookOptions.After.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-webhooks/#api-rest-api-2-webhook-failed-get
+func (s *WebhookService) GetFailed(ctx context.Context, options *FailedWebhookOptions) ([]FailedWebhook, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/webhook/failed", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(failedWebhookResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result.Values, resp, nil
+}
+
+// webhookRefreshMargin is the time before the expiration at which AutoRefresh refreshes webhooks.
+// webhookRefreshRetry is the delay before a failed refresh is retried.
+var (
+	webhookRefreshMargin = 7 * 24 * time.Hour
+	webhookRefreshRetry  = time.Minute
+)
+
+// AutoRefresh refreshes the webhooks with the given IDs, before they expire,
+// until ctx is done. It blocks and should be run in its own goroutine:
+//
+//	go client.Webhook.AutoRefresh(ctx, ids, func(err error) { log.Print(err) })
+//
+// Failed refreshes are reported to onError (which may be nil) and retried.
+// AutoRefresh returns ctx.Err() once ctx is done.
+func (s *WebhookService) AutoRefresh(ctx context.Context, webhookIDs []int, onError func(error)) error {
+	for {
+		wait := webhookRefreshRetry
+		expires, _, err := s.Refresh(ctx, webhookIDs...)
+		if err != nil {
+			if onError != nil && ctx.Err() == nil {
+				onError(fmt.Errorf("refreshing webhooks %v: %w", webhookIDs, err))
+			}
+		} else if next := time.Until(expires) - webhookRefreshMargin; next > wait {
+			wait = next
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+	"github.com/andygrunwald/go-jira/v2/internal/core"
+)
+
+// Webhook event types.
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/webhooks/#events
+const (
+	EventIssueCreated = "jira:issue_created"
+	EventIssueUpdated = "jira:issue_updated"
+	EventIssueDeleted = "jira:issue_deleted"
+
+	EventCommentCreated = "comment_created"
+	EventCommentUpdated = "comment_updated"
+	EventCommentDeleted = "comment_deleted"
+
+	EventWorklogCreated = "worklog_created"
+	EventWorklogUpdated = "worklog_updated"
+	EventWorklogDeleted = "worklog_deleted"
+
+	EventSprintCreated = "sprint_created"
+	EventSprintStarted = "sprint_started"
+	EventSprintUpdated = "sprint_updated"
+	EventSprintClosed  = "sprint_closed"
+	EventSprintDeleted = "sprint_deleted"
+)
+
+// Event contains the attributes all webhook payloads have in common.
+type Event = core.WebhookEvent
+
+// IssueEvent is sent for the jira:issue_* events.
+type IssueEvent struct {
+	Event
+	IssueEventTypeName string                 `json:"issue_event_type_name"`
+	User               *jira.User             `json:"user"`
+	Issue              *jira.Issue            `json:"issue"`
+	Changelog          *jira.ChangelogHistory `json:"changelog"`
+	Comment            *jira.Comment          `json:"comment"`
+}
+
+// CommentEvent is sent for the comment_* events.
+type CommentEvent struct {
+	Event
+	Comment *jira.Comment `json:"comment"`
+	Issue   *jira.Issue   `json:"issue"`
+}
+
+// WorklogEvent is sent for the worklog_* events.
+type WorklogEvent struct {
+	Event
+	Worklog *jira.WorklogRecord `json:"worklog"`
+}
+
+// SprintEvent is sent for the sprint_* events.
+type SprintEvent struct {
+	Event
+	Sprint *jira.Sprint `json:"sprint"`
+	// OldValue is the sprint before the change (sprint_updated only).
+	OldValue *jira.Sprint `json:"oldValue"`
+}
+
+// ParseEvent parses a webhook payload.
+// It returns an *IssueEvent, *CommentEvent, *WorklogEvent or *SprintEvent
+// depending on the event type, or an *Event for all other events.
+func ParseEvent(payload []byte) (interface{}, error) {
+	return core.ParseWebhookEvent(payload, func(k core.WebhookKind) interface{} {
+		switch k {
+		case core.WebhookKindIssue:
+			return &IssueEvent{}
+		case core.WebhookKindComment:
+			return &CommentEvent{}
+		case core.WebhookKindWorklog:
+			return &WorklogEvent{}
+		case core.WebhookKindSprint:
+			return &SprintEvent{}
+		}
+		return nil
+	})
+}
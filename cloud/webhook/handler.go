@@ -0,0 +1,98 @@
+// Package webhook receives Jira Cloud webhooks.
+//
+// A Handler parses the webhook payloads into typed events and dispatches them to the
+// functions registered per event type:
+//
+//	h := &webhook.Handler{Secret: "..."}
+//	h.OnIssue(webhook.EventIssueCreated, func(ctx context.Context, e *webhook.IssueEvent) error {
+//		fmt.Println("created", e.Issue.Key)
+//		return nil
+//	})
+//	http.Handle("/jira/webhook", h)
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/webhooks/
+package webhook
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/andygrunwald/go-jira/v2/internal/core"
+)
+
+// SignatureHeader is the header carrying the HMAC signature of the payload,
+// e.g. "sha256=5c2f...".
+const SignatureHeader = core.WebhookSignatureHeader
+
+// ErrInvalidSignature is returned if the signature of a payload doesn't match the secret.
+var ErrInvalidSignature = core.ErrInvalidWebhookSignature
+
+// Handler is an http.Handler receiving Jira webhooks.
+//
+// Requests with an invalid signature or secret are answered with 401 Unauthorized,
+// invalid payloads with 400 Bad Request and handler errors with 500 Internal Server Error.
+// Events without a registered handler are acknowledged with 200 OK.
+type Handler struct {
+	// Secret is the secret configured for the webhook.
+	// If set, the X-Hub-Signature header of every request is verified.
+	Secret string
+
+	// URLSecret is compared to the "secret" query parameter of every request, if set.
+	// This is for Jira versions that can't sign webhooks: Add the secret to the webhook URL,
+	// e.g. https://example.com/jira/webhook?secret=...
+	URLSecret string
+
+	// ErrorLog is called with errors returned by the event handlers.
+	// Errors are not logged if nil.
+	ErrorLog func(err error)
+
+	mux core.WebhookMux
+}
+
+// OnIssue registers f for an jira:issue_* event type.
+// It panics if eventType is not an issue event.
+func (h *Handler) OnIssue(eventType string, f func(context.Context, *IssueEvent) error) {
+	core.HandleWebhook(&h.mux, "OnIssue", eventType, core.WebhookKindIssue, f)
+}
+
+// OnComment registers f for a comment_* event type.
+// It panics if eventType is not a comment event.
+func (h *Handler) OnComment(eventType string, f func(context.Context, *CommentEvent) error) {
+	core.HandleWebhook(&h.mux, "OnComment", eventType, core.WebhookKindComment, f)
+}
+
+// OnWorklog registers f for a worklog_* event type.
+// It panics if eventType is not a worklog event.
+func (h *Handler) OnWorklog(eventType string, f func(context.Context, *WorklogEvent) error) {
+	core.HandleWebhook(&h.mux, "OnWorklog", eventType, core.WebhookKindWorklog, f)
+}
+
+// OnSprint registers f for a sprint_* event type.
+// It panics if eventType is not a sprint event.
+func (h *Handler) OnSprint(eventType string, f func(context.Context, *SprintEvent) error) {
+	core.HandleWebhook(&h.mux, "OnSprint", eventType, core.WebhookKindSprint, f)
+}
+
+// OnEvent registers f for an event type without a typed event, e.g. "project_created".
+// The payload is available as Event.Payload.
+// It panics if eventType has a typed event, use OnIssue, OnComment, OnWorklog or OnSprint for those.
+func (h *Handler) OnEvent(eventType string, f func(context.Context, *Event) error) {
+	core.HandleWebhook(&h.mux, "OnEvent", eventType, core.WebhookKindOther, f)
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.Serve(w, r, core.WebhookOptions{
+		Secret:    h.Secret,
+		URLSecret: h.URLSecret,
+		ErrorLog:  h.ErrorLog,
+		Parse:     ParseEvent,
+	})
+}
+
+// VerifySignature verifies the HMAC signature of payload.
+// signature is the value of the X-Hub-Signature header, e.g. "sha256=5c2f...".
+// The algorithms sha256 and sha1 are supported.
+func VerifySignature(secret string, payload []byte, signature string) error {
+	return core.VerifyWebhookSignature(secret, payload, signature)
+}
@@ -0,0 +1,126 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func sign(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func serve(h http.Handler, target, payload string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(payload))
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler_Dispatch(t *testing.T) {
+	h := &Handler{}
+
+	var issueKey string
+	h.OnIssue(EventIssueUpdated, func(ctx context.Context, e *IssueEvent) error {
+		issueKey = e.Issue.Key
+		return nil
+	})
+	h.OnIssue(EventIssueCreated, func(ctx context.Context, e *IssueEvent) error {
+		t.Error("Unexpected call of the jira:issue_created handler")
+		return nil
+	})
+
+	rec := serve(h, "/", issueUpdatedPayload, nil)
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", rec.Code)
+	}
+	if issueKey != "EX-1" {
+		t.Errorf("Expected the handler to be called with EX-1, got %q", issueKey)
+	}
+}
+
+func TestHandler_OnEvent(t *testing.T) {
+	h := &Handler{}
+	called := false
+	h.OnEvent("project_created", func(ctx context.Context, e *Event) error {
+		called = strings.Contains(string(e.Payload), `"EX"`)
+		return nil
+	})
+
+	serve(h, "/", `{"webhookEvent":"project_created","project":{"key":"EX"}}`, nil)
+	if !called {
+		t.Error("Expected the handler to be called with the payload")
+	}
+}
+
+func TestHandler_HandlerError(t *testing.T) {
+	var logged error
+	h := &Handler{ErrorLog: func(err error) { logged = err }}
+	h.OnComment(EventCommentCreated, func(ctx context.Context, e *CommentEvent) error {
+		return errors.New("boom")
+	})
+
+	rec := serve(h, "/", `{"webhookEvent":"comment_created","comment":{"id":"1"}}`, nil)
+	if rec.Code != http.StatusInternalServerError || logged == nil {
+		t.Errorf("Expected status 500 and a logged error, got %d and %v", rec.Code, logged)
+	}
+}
+
+func TestHandler_MismatchingEventType(t *testing.T) {
+	tests := map[string]func(h *Handler){
+		"OnIssue": func(h *Handler) {
+			h.OnIssue(EventCommentCreated, func(ctx context.Context, e *IssueEvent) error { return nil })
+		},
+		"OnComment": func(h *Handler) {
+			h.OnComment(EventIssueUpdated, func(ctx context.Context, e *CommentEvent) error { return nil })
+		},
+		"OnWorklog": func(h *Handler) {
+			h.OnWorklog(EventSprintStarted, func(ctx context.Context, e *WorklogEvent) error { return nil })
+		},
+		"OnSprint": func(h *Handler) {
+			h.OnSprint("project_created", func(ctx context.Context, e *SprintEvent) error { return nil })
+		},
+		"OnEvent": func(h *Handler) {
+			h.OnEvent(EventWorklogCreated, func(ctx context.Context, e *Event) error { return nil })
+		},
+	}
+
+	for name, register := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected %s to panic for a mismatching event type", name)
+				}
+			}()
+			register(&Handler{})
+		})
+	}
+}
+
+func TestHandler_Signature(t *testing.T) {
+	h := &Handler{Secret: "s3cr3t"}
+	payload := `{"webhookEvent":"sprint_started","sprint":{"id":1}}`
+
+	rec := serve(h, "/", payload, http.Header{SignatureHeader: {sign("s3cr3t", payload)}})
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200 for a valid signature, got %d", rec.Code)
+	}
+
+	for _, signature := range []string{"", sign("wrong", payload), "sha256=zz", "md5=abc"} {
+		rec = serve(h, "/", payload, http.Header{SignatureHeader: {signature}})
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected status 401 for signature %q, got %d", signature, rec.Code)
+		}
+	}
+}
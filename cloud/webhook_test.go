@@ -0,0 +1,178 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWebhookService_Register(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/webhook", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body struct {
+			Webhooks []Webhook `json:"webhooks"`
+			URL      string    `json:"url"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.URL != "https://app.example.com/webhook" || len(body.Webhooks) != 2 {
+			t.Errorf("Unexpected request body: %+v", body)
+		}
+		if body.Webhooks[0].JQLFilter != "project = EX" || !reflect.DeepEqual(body.Webhooks[0].Events, []string{"jira:issue_created"}) {
+			t.Errorf("Unexpected webhook: %+v", body.Webhooks[0])
+		}
+		fmt.Fprint(w, `{"webhookRegistrationResult":[{"createdWebhookId":1000},{"errors":["The clause watchCount is unsupported"]}]}`)
+	})
+
+	results, _, err := testClient.Webhook.Register(context.Background(), "https://app.example.com/webhook", []Webhook{
+		{JQLFilter: "project = EX", Events: []string{"jira:issue_created"}},
+		{JQLFilter: "watchCount > 1", Events: []string{"jira:issue_updated"}},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := []WebhookRegistrationResult{
+		{CreatedWebhookID: 1000},
+		{Errors: []string{"The clause watchCount is unsupported"}},
+	}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("Register returned %+v, want %+v", results, want)
+	}
+}
+
+func TestWebhookService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/webhook", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"startAt": "1", "maxResults": "1"})
+		fmt.Fprint(w, `{"maxResults":1,"startAt":1,"total":2,"isLast":true,"values":[
+			{"id":1001,"jqlFilter":"project = EX","events":["jira:issue_updated"],"fieldIdsFilter":["summary"],"expirationDate":1589005800000}
+		]}`)
+	})
+
+	webhooks, resp, err := testClient.Webhook.GetList(context.Background(), &WebhookListOptions{StartAt: 1, MaxResults: 1})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(webhooks) != 1 || webhooks[0].ID != 1001 || webhooks[0].FieldIDsFilter[0] != "summary" {
+		t.Errorf("Unexpected webhooks: %+v", webhooks)
+	}
+	if !webhooks[0].Expires().Equal(time.UnixMilli(1589005800000)) {
+		t.Errorf("Unexpected expiration %v", webhooks[0].Expires())
+	}
+	if resp.StartAt != 1 || resp.Total != 2 || !resp.IsLast {
+		t.Errorf("Unexpected paging values: %+v", resp)
+	}
+}
+
+func TestWebhookService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/webhook", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		var body map[string][]int
+		json.NewDecoder(r.Body).Decode(&body)
+		if !reflect.DeepEqual(body["webhookIds"], []int{1000, 1001}) {
+			t.Errorf("Unexpected request body: %v", body)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	if _, err := testClient.Webhook.Delete(context.Background(), 1000, 1001); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestWebhookService_Refresh(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/webhook/refresh", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"expirationDate":1589005800000}`)
+	})
+
+	expires, _, err := testClient.Webhook.Refresh(context.Background(), 1000)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !expires.Equal(time.UnixMilli(1589005800000)) {
+		t.Errorf("Unexpected expiration %v", expires)
+	}
+}
+
+func TestWebhookService_GetFailed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/webhook/failed", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"maxResults": "2", "after": "1573118132000"})
+		fmt.Fprint(w, `{"values":[
+			{"id":"1","body":"{\"data\":\"webhook data\"}","url":"https://example.com","failureTime":1573118132000}
+		],"maxResults":2,"next":"https://your-domain.atlassian.net/rest/api/2/webhook/failed?after=1573540473480&maxResults=2"}`)
+	})
+
+	failed, resp, err := testClient.Webhook.GetFailed(context.Background(), &FailedWebhookOptions{MaxResults: 2, After: "1573118132000"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(failed) != 1 || failed[0].URL != "https://example.com" {
+		t.Errorf("Unexpected failed webhooks: %+v", failed)
+	}
+	if resp.IsLast || resp.NextPageToken != "1573540473480" {
+		t.Errorf("Expected the cursor of the next page, got %+v", resp)
+	}
+}
+
+func TestWebhookService_AutoRefresh(t *testing.T) {
+	setup()
+	defer teardown()
+
+	margin, retry := webhookRefreshMargin, webhookRefreshRetry
+	webhookRefreshMargin, webhookRefreshRetry = time.Hour, 10*time.Millisecond
+	defer func() { webhookRefreshMargin, webhookRefreshRetry = margin, retry }()
+
+	var mu sync.Mutex
+	refreshes := 0
+	testMux.HandleFunc("/rest/api/2/webhook/refresh", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		refreshes++
+		if refreshes == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		// expires in 1 hour (the margin) and 50ms: the next refresh is due after 50ms
+		fmt.Fprintf(w, `{"expirationDate":%d}`, time.Now().Add(time.Hour+50*time.Millisecond).UnixMilli())
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 130*time.Millisecond)
+	defer cancel()
+
+	var errs []error
+	err := testClient.Webhook.AutoRefresh(ctx, []int{1000}, func(err error) { errs = append(errs, err) })
+	if err != context.DeadlineExceeded {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if len(errs) != 1 {
+		t.Errorf("Expected 1 reported error, got %v", errs)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	// failed at 0ms, retried at 10ms, refreshed at ~60ms and ~110ms
+	if refreshes < 3 || refreshes > 5 {
+		t.Errorf("Expected 3 to 5 refreshes, got %d", refreshes)
+	}
+}
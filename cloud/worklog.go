@@ -0,0 +1,66 @@
+package cloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/andygrunwald/go-jira/v2/internal/core"
+)
+
+// WorklogService handles the worklogs of all issues for the Jira instance / API.
+// The worklogs of a single issue are handled by the IssueService.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklogs/
+type WorklogService service
+
+// WorklogChange is a worklog that was updated or deleted.
+type WorklogChange = core.WorklogChange
+
+// WorklogChanges are the worklogs updated or deleted in a period of time.
+// Since and Until are in milliseconds since the Unix epoch.
+type WorklogChanges = core.WorklogChanges
+
+// WorklogListOptions specifies the optional parameters of WorklogService.GetUpdatedSince and GetByIDs.
+type WorklogListOptions = core.WorklogListOptions
+
+// GetUpdatedSince returns the IDs of the worklogs updated since the given time,
+// following the cursor until the last page.
+// Worklogs updated in the last minute are not included, use the Until of the result as since of the next call.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklogs/#api-rest-api-2-worklog-updated-get
+func (s *WorklogService) GetUpdatedSince(ctx context.Context, since time.Time, options *WorklogListOptions) (*WorklogChanges, *Response, error) {
+	return core.GetUpdatedWorklogs(ctx, s.client, since, options)
+}
+
+// GetDeletedSince returns the IDs of the worklogs deleted since the given time,
+// following the cursor until the last page.
+// Worklogs deleted in the last minute are not included, use the Until of the result as since of the next call.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklogs/#api-rest-api-2-worklog-deleted-get
+func (s *WorklogService) GetDeletedSince(ctx context.Context, since time.Time) (*WorklogChanges, *Response, error) {
+	return core.GetDeletedWorklogs(ctx, s.client, since)
+}
+
+// GetByIDs returns the worklogs with the given IDs.
+// Worklogs the user is not allowed to see are left out.
+// Jira returns at most 1000 worklogs per request, more IDs are fetched in several requests.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklogs/#api-rest-api-2-worklog-list-post
+func (s *WorklogService) GetByIDs(ctx context.Context, worklogIDs []int, options *WorklogListOptions) ([]WorklogRecord, *Response, error) {
+	return core.GetWorklogsByIDs[WorklogRecord](ctx, s.client, worklogIDs, options)
+}
+
+// WorklogSyncHandler receives the changes of WorklogService.Sync.
+// Updated is called for every created or updated worklog, Deleted with the ID of every deleted worklog.
+type WorklogSyncHandler = core.WorklogSyncHandler[WorklogRecord]
+
+// Sync streams the worklogs updated and deleted since the given time to h,
+// so that an external store can be kept in sync without fetching the worklogs of every issue.
+// The updated worklogs are passed first, then the deleted ones.
+// It stops and returns the error if a function of h returns one.
+//
+// Sync returns the time to pass as since to the next call.
+// Worklogs changed at that time may be passed again by the next call, so the handlers should be idempotent.
+func (s *WorklogService) Sync(ctx context.Context, since time.Time, h WorklogSyncHandler) (time.Time, error) {
+	return core.SyncWorklogs(ctx, s.client, since, h)
+}
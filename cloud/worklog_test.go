@@ -0,0 +1,90 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// testWorklogFeedHandlers serves two pages of updated worklogs (10000, 10001) and one page of deleted worklogs (10002)
+// since 1438013671562, and the worklogs by ID.
+func testWorklogFeedHandlers(t *testing.T) {
+	testMux.HandleFunc("/rest/api/2/worklog/updated", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("since") {
+		case "1438013671562":
+			fmt.Fprint(w, `{"values":[{"worklogId":10000,"updatedTime":1438013671562}],"since":1438013671562,"until":1438013693136,"self":"https://your-domain.atlassian.net/rest/api/2/worklog/updated?since=1438013671562","nextPage":"https://your-domain.atlassian.net/rest/api/2/worklog/updated?since=1438013693136","lastPage":false}`)
+		case "1438013693136":
+			fmt.Fprint(w, `{"values":[{"worklogId":10001,"updatedTime":1438013693136}],"since":1438013693136,"until":1438013700000,"self":"https://your-domain.atlassian.net/rest/api/2/worklog/updated?since=1438013693136","lastPage":true}`)
+		default:
+			t.Errorf("Unexpected since %s", r.URL.Query().Get("since"))
+		}
+	})
+	testMux.HandleFunc("/rest/api/2/worklog/deleted", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"since": "1438013671562"})
+		fmt.Fprint(w, `{"values":[{"worklogId":10002,"updatedTime":1438013680000}],"since":1438013671562,"until":1438013690000,"lastPage":true}`)
+	})
+	testMux.HandleFunc("/rest/api/2/worklog/list", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body struct {
+			IDs []int `json:"ids"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Error decoding body: %s", err)
+		}
+		var records []WorklogRecord
+		for _, id := range body.IDs {
+			records = append(records, WorklogRecord{ID: fmt.Sprint(id), IssueID: "10010", TimeSpentSeconds: 3600})
+		}
+		json.NewEncoder(w).Encode(records)
+	})
+}
+
+func TestWorklogService_GetUpdatedSince(t *testing.T) {
+	setup()
+	defer teardown()
+	testWorklogFeedHandlers(t)
+
+	changes, _, err := testClient.Worklog.GetUpdatedSince(context.Background(), time.UnixMilli(1438013671562), nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(changes.Values) != 2 || changes.Values[1].WorklogID != 10001 {
+		t.Errorf("Expected the changes of both pages, got %+v", changes.Values)
+	}
+	if changes.Until != 1438013700000 || !changes.LastPage {
+		t.Errorf("Unexpected until %d / last page %t", changes.Until, changes.LastPage)
+	}
+}
+
+func TestWorklogService_Sync(t *testing.T) {
+	setup()
+	defer teardown()
+	testWorklogFeedHandlers(t)
+
+	var updated []string
+	var deleted []int
+	next, err := testClient.Worklog.Sync(context.Background(), time.UnixMilli(1438013671562), WorklogSyncHandler{
+		Updated: func(w WorklogRecord) error {
+			updated = append(updated, w.ID)
+			return nil
+		},
+		Deleted: func(worklogID int) error {
+			deleted = append(deleted, worklogID)
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if fmt.Sprint(updated) != "[10000 10001]" || fmt.Sprint(deleted) != "[10002]" {
+		t.Errorf("Unexpected changes: updated %v, deleted %v", updated, deleted)
+	}
+	if next.UnixMilli() != 1438013690000 {
+		t.Errorf("Expected to continue at the until of the deleted worklogs, got %d", next.UnixMilli())
+	}
+}
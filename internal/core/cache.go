@@ -0,0 +1,139 @@
+package core
+
+import (
+	"container/list"
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultCacheSize is the number of responses kept by the LRUCache
+// that a CacheTransport creates if no Cache has been configured.
+const DefaultCacheSize = 256
+
+// CacheEntry represents a cached API response.
+type CacheEntry struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+
+	// Expires is the point in time after which the entry needs to be revalidated.
+	Expires time.Time
+}
+
+// ETag returns the entity tag of the cached response, if Jira sent one.
+func (e *CacheEntry) ETag() string {
+	return e.Header.Get("ETag")
+}
+
+// LastModified returns the Last-Modified header of the cached response, if Jira sent one.
+func (e *CacheEntry) LastModified() string {
+	return e.Header.Get("Last-Modified")
+}
+
+// Cache is a storage for API responses used by the CacheTransport.
+// Implement this interface to plug in a shared cache (like Redis or memcached).
+// Implementations must be safe for concurrent use.
+// A Cache shared between users needs a distinct CacheTransport.Partition per user.
+type Cache interface {
+	// Get returns the entry stored under key.
+	// A cache that failed to retrieve the entry should report it as missing.
+	Get(ctx context.Context, key string) (*CacheEntry, bool)
+
+	// Set stores the entry under key.
+	Set(ctx context.Context, key string, entry *CacheEntry)
+
+	// Delete removes the entry stored under key.
+	Delete(ctx context.Context, key string)
+
+	// Purge removes all entries.
+	Purge(ctx context.Context)
+}
+
+// LRUCache is an in-memory Cache that evicts the least recently used
+// entry once it holds more than its configured capacity.
+type LRUCache struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[string]*list.Element
+}
+
+type lruItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// NewLRUCache returns an LRUCache that holds up to capacity entries.
+// A capacity <= 0 falls back to DefaultCacheSize.
+func NewLRUCache(capacity int) *LRUCache {
+	if capacity <= 0 {
+		capacity = DefaultCacheSize
+	}
+	return &LRUCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// Get implements the Cache interface.
+func (c *LRUCache) Get(_ context.Context, key string) (*CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(el)
+	return el.Value.(*lruItem).entry, true
+}
+
+// Set implements the Cache interface.
+func (c *LRUCache) Set(_ context.Context, key string, entry *CacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		el.Value.(*lruItem).entry = entry
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&lruItem{key: key, entry: entry})
+	for c.ll.Len() > c.capacity {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruItem).key)
+	}
+}
+
+// Delete implements the Cache interface.
+func (c *LRUCache) Delete(_ context.Context, key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.ll.Remove(el)
+		delete(c.items, key)
+	}
+}
+
+// Purge implements the Cache interface.
+func (c *LRUCache) Purge(_ context.Context) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ll.Init()
+	c.items = make(map[string]*list.Element)
+}
+
+// Len returns the number of cached entries.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.ll.Len()
+}
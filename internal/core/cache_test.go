@@ -0,0 +1,47 @@
+package core
+
+import (
+	"context"
+	"testing"
+)
+
+func TestLRUCache_Evicts(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRUCache(2)
+
+	c.Set(ctx, "a", &CacheEntry{Body: []byte("a")})
+	c.Set(ctx, "b", &CacheEntry{Body: []byte("b")})
+
+	// touch "a", so that "b" is the least recently used entry
+	if _, ok := c.Get(ctx, "a"); !ok {
+		t.Fatal("Expected entry a to be cached")
+	}
+	c.Set(ctx, "c", &CacheEntry{Body: []byte("c")})
+
+	if _, ok := c.Get(ctx, "b"); ok {
+		t.Error("Expected entry b to be evicted")
+	}
+	if _, ok := c.Get(ctx, "a"); !ok {
+		t.Error("Expected entry a to be cached")
+	}
+	if c.Len() != 2 {
+		t.Errorf("Expected 2 entries, got %d", c.Len())
+	}
+}
+
+func TestLRUCache_DeleteAndPurge(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRUCache(0)
+
+	c.Set(ctx, "a", &CacheEntry{})
+	c.Set(ctx, "b", &CacheEntry{})
+	c.Delete(ctx, "a")
+	if _, ok := c.Get(ctx, "a"); ok {
+		t.Error("Expected entry a to be deleted")
+	}
+
+	c.Purge(ctx)
+	if c.Len() != 0 {
+		t.Errorf("Expected empty cache, got %d entries", c.Len())
+	}
+}
@@ -0,0 +1,267 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheHeader is set on responses that have been served from the cache.
+const CacheHeader = "X-From-Cache"
+
+// CacheMode controls how the CacheTransport treats a single request.
+// See WithCacheMode.
+type CacheMode int
+
+const (
+	// CacheModeDefault serves fresh entries from the cache and stores new responses.
+	CacheModeDefault CacheMode = iota
+	// CacheModeBypass neither reads from nor writes to the cache.
+	CacheModeBypass
+	// CacheModeRefresh always asks Jira and replaces the cached entry with the response.
+	CacheModeRefresh
+)
+
+type cacheContextKey int
+
+const (
+	cacheModeKey cacheContextKey = iota
+	cacheTTLKey
+)
+
+// WithCacheMode returns a copy of ctx that makes the CacheTransport
+// handle requests sent with it according to mode.
+func WithCacheMode(ctx context.Context, mode CacheMode) context.Context {
+	return context.WithValue(ctx, cacheModeKey, mode)
+}
+
+// WithCacheTTL returns a copy of ctx that makes the CacheTransport cache
+// GET responses for ttl, even if the endpoint is not covered by one of its rules.
+func WithCacheTTL(ctx context.Context, ttl time.Duration) context.Context {
+	return context.WithValue(ctx, cacheTTLKey, ttl)
+}
+
+// CacheRule defines how long responses of an endpoint are cached.
+type CacheRule struct {
+	// Path is the API endpoint without a leading slash, e.g. "rest/api/2/field".
+	// Sub-resources of the endpoint (like "rest/api/2/field/{id}") are covered as well.
+	Path string
+	TTL  time.Duration
+}
+
+// DefaultCacheRules covers the metadata endpoints that rarely change,
+// like fields, priorities, statuses, resolutions, issue link types and the create meta data.
+var DefaultCacheRules = []CacheRule{
+	{Path: "rest/api/2/field", TTL: time.Hour},
+	{Path: "rest/api/2/priority", TTL: time.Hour},
+	{Path: "rest/api/2/status", TTL: time.Hour},
+	{Path: "rest/api/2/resolution", TTL: time.Hour},
+	{Path: "rest/api/2/issueLinkType", TTL: time.Hour},
+	{Path: "rest/api/2/issue/createmeta", TTL: time.Hour},
+}
+
+// CacheTransport is an http.RoundTripper that caches the responses of GET requests.
+//
+// Only endpoints matching one of the Rules are cached, each for the TTL of its rule.
+// Once an entry expired, it is revalidated with a conditional request
+// (If-None-Match / If-Modified-Since) if Jira sent an ETag or Last-Modified header.
+// A 304 Not Modified answer renews the cached entry.
+//
+// The behaviour can be changed per call via the context, see WithCacheMode and WithCacheTTL.
+//
+// The cache is looked up before the request reaches the underlying Transport,
+// so its authentication does not keep the responses of different users apart.
+// Responses of permission filtered endpoints, like the create meta data, would be served to every user of the same entries.
+// Use a Cache per user, or a distinct Partition per user if a Cache is shared.
+type CacheTransport struct {
+	// Cache stores the responses.
+	// It will default to an LRUCache of DefaultCacheSize entries if nil.
+	Cache Cache
+
+	// Partition is part of every cache key and separates the responses of different users in a shared Cache,
+	// e.g. the account ID or username the Transport authenticates as.
+	// CacheTransports of different users must not share a Cache with the same Partition.
+	Partition string
+
+	// Rules define which endpoints are cached and for how long.
+	// It will default to DefaultCacheRules if nil.
+	Rules []CacheRule
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	once         sync.Once
+	defaultCache Cache
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *CacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	mode, _ := ctx.Value(cacheModeKey).(CacheMode)
+	ttl, cacheable := t.ttl(req)
+	if req.Method != http.MethodGet || mode == CacheModeBypass || !cacheable {
+		return t.transport().RoundTrip(req)
+	}
+
+	cache := t.cache()
+	key := t.key(req.URL)
+
+	var entry *CacheEntry
+	if mode != CacheModeRefresh {
+		entry, _ = cache.Get(ctx, key)
+	}
+	if entry != nil && time.Now().Before(entry.Expires) {
+		return entry.response(req), nil
+	}
+
+	req2 := req
+	if entry != nil && (entry.ETag() != "" || entry.LastModified() != "") {
+		req2 = CloneRequest(req) // per RoundTripper contract
+		if etag := entry.ETag(); etag != "" {
+			req2.Header.Set("If-None-Match", etag)
+		}
+		if lastModified := entry.LastModified(); lastModified != "" {
+			req2.Header.Set("If-Modified-Since", lastModified)
+		}
+	}
+
+	resp, err := t.transport().RoundTrip(req2)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && entry != nil {
+		resp.Body.Close()
+		renewed := *entry
+		renewed.Expires = time.Now().Add(ttl)
+		cache.Set(ctx, key, &renewed)
+		return renewed.response(req), nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	cache.Set(ctx, key, &CacheEntry{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       body,
+		Expires:    time.Now().Add(ttl),
+	})
+
+	return resp, nil
+}
+
+// Invalidate removes the cached response of the given absolute URL,
+// e.g. "https://jira.example.com/rest/api/2/field" or "https://jira.example.com/rest/api/2/issue/createmeta?projectKeys=ABC".
+func (t *CacheTransport) Invalidate(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	t.cache().Delete(ctx, t.key(u))
+	return nil
+}
+
+// InvalidateAll removes all cached responses.
+// If the Cache is shared, the responses of the other partitions are removed as well.
+func (t *CacheTransport) InvalidateAll(ctx context.Context) {
+	t.cache().Purge(ctx)
+}
+
+// Client returns an *http.Client that caches responses.
+func (t *CacheTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *CacheTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+func (t *CacheTransport) cache() Cache {
+	if t.Cache != nil {
+		return t.Cache
+	}
+	t.once.Do(func() {
+		t.defaultCache = NewLRUCache(DefaultCacheSize)
+	})
+	return t.defaultCache
+}
+
+func (t *CacheTransport) rules() []CacheRule {
+	if t.Rules != nil {
+		return t.Rules
+	}
+	return DefaultCacheRules
+}
+
+// ttl returns how long the response of req should be cached
+// and if it should be cached at all.
+func (t *CacheTransport) ttl(req *http.Request) (time.Duration, bool) {
+	if ttl, ok := req.Context().Value(cacheTTLKey).(time.Duration); ok {
+		return ttl, ttl > 0
+	}
+
+	for _, rule := range t.rules() {
+		if matchesEndpoint(req.URL.Path, rule.Path) {
+			return rule.TTL, rule.TTL > 0
+		}
+	}
+	return 0, false
+}
+
+// matchesEndpoint reports if path points to endpoint or one of its sub-resources.
+// The path may contain a prefix, e.g. if Jira is served under a context path like "/jira/".
+func matchesEndpoint(path, endpoint string) bool {
+	endpoint = "/" + strings.Trim(endpoint, "/")
+	i := strings.Index(path, endpoint)
+	if i < 0 {
+		return false
+	}
+	rest := path[i+len(endpoint):]
+	return rest == "" || strings.HasPrefix(rest, "/")
+}
+
+// key returns the cache key of u: The Partition, followed by the scheme, host and request URI,
+// e.g. "5b10ac8d82e05b22cc7d4ef5 https://jira.example.com/rest/api/2/field?expand=x".
+// The host is part of the key, so that several Jira instances can share a Cache.
+func (t *CacheTransport) key(u *url.URL) string {
+	return t.Partition + " " + u.Scheme + "://" + u.Host + u.RequestURI()
+}
+
+func (e *CacheEntry) response(req *http.Request) *http.Response {
+	header := e.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set(CacheHeader, "1")
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode)),
+		StatusCode:    e.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
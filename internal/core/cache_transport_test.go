@@ -0,0 +1,260 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// testCacheServer serves the given body on every path and counts the calls.
+func testCacheServer(body string, calls *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		fmt.Fprint(w, body)
+	}))
+}
+
+// get sends a GET request via client and returns the body.
+func get(t *testing.T, ctx context.Context, client *http.Client, url string) (*http.Response, string) {
+	t.Helper()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	return resp, string(body)
+}
+
+func TestCacheTransport_CachesMetadataEndpoints(t *testing.T) {
+	calls := 0
+	server := testCacheServer(`[{"id":"1","name":"Blocker"}]`, &calls)
+	defer server.Close()
+
+	client := (&CacheTransport{}).Client()
+	for i := 0; i < 3; i++ {
+		if _, body := get(t, context.Background(), client, server.URL+"/rest/api/2/priority"); body != `[{"id":"1","name":"Blocker"}]` {
+			t.Errorf("Unexpected body %s", body)
+		}
+	}
+	get(t, context.Background(), client, server.URL+"/rest/api/2/issue/10002")
+	get(t, context.Background(), client, server.URL+"/rest/api/2/issue/10002")
+	if calls != 3 {
+		t.Errorf("Expected 1 call of the priorities and 2 of the issue, got %d", calls)
+	}
+}
+
+func TestCacheTransport_ContextModes(t *testing.T) {
+	calls := 0
+	server := testCacheServer(`[]`, &calls)
+	defer server.Close()
+
+	client := (&CacheTransport{}).Client()
+	ctx := context.Background()
+	url := server.URL + "/rest/api/2/resolution"
+
+	get(t, ctx, client, url)
+	get(t, WithCacheMode(ctx, CacheModeBypass), client, url)
+	if calls != 2 {
+		t.Errorf("Expected bypass to hit Jira, got %d calls", calls)
+	}
+
+	get(t, WithCacheMode(ctx, CacheModeRefresh), client, url)
+	if calls != 3 {
+		t.Errorf("Expected refresh to hit Jira, got %d calls", calls)
+	}
+
+	get(t, ctx, client, url)
+	if calls != 3 {
+		t.Errorf("Expected cached response after refresh, got %d calls", calls)
+	}
+}
+
+func TestCacheTransport_WithCacheTTL(t *testing.T) {
+	calls := 0
+	server := testCacheServer(`{"id":"10000","key":"EX"}`, &calls)
+	defer server.Close()
+
+	client := (&CacheTransport{}).Client()
+	ctx := WithCacheTTL(context.Background(), time.Minute)
+	get(t, ctx, client, server.URL+"/rest/api/2/project/EX")
+	get(t, ctx, client, server.URL+"/rest/api/2/project/EX")
+	if calls != 1 {
+		t.Errorf("Expected 1 call to Jira, got %d", calls)
+	}
+}
+
+func TestCacheTransport_Revalidate(t *testing.T) {
+	calls, notModified := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `[{"id":"summary","name":"Summary"}]`)
+	}))
+	defer server.Close()
+
+	client := (&CacheTransport{Rules: []CacheRule{{Path: "rest/api/2/field", TTL: time.Nanosecond}}}).Client()
+
+	get(t, context.Background(), client, server.URL+"/rest/api/2/field")
+	time.Sleep(time.Millisecond)
+	resp, body := get(t, context.Background(), client, server.URL+"/rest/api/2/field")
+	if body != `[{"id":"summary","name":"Summary"}]` {
+		t.Errorf("Unexpected body %s", body)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	}
+	if resp.Header.Get(CacheHeader) == "" {
+		t.Errorf("Expected response to be served from the cache")
+	}
+	if calls != 2 || notModified != 1 {
+		t.Errorf("Expected 2 calls with 1 revalidation, got %d calls and %d revalidations", calls, notModified)
+	}
+}
+
+func TestCacheTransport_Invalidate(t *testing.T) {
+	calls := 0
+	server := testCacheServer(`[]`, &calls)
+	defer server.Close()
+
+	tp := &CacheTransport{}
+	client := tp.Client()
+	ctx := context.Background()
+	url := server.URL + "/rest/api/2/status"
+
+	get(t, ctx, client, url)
+	if err := tp.Invalidate(ctx, url); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	get(t, ctx, client, url)
+	if calls != 2 {
+		t.Errorf("Expected 2 calls after Invalidate, got %d", calls)
+	}
+
+	tp.InvalidateAll(ctx)
+	get(t, ctx, client, url)
+	if calls != 3 {
+		t.Errorf("Expected 3 calls after InvalidateAll, got %d", calls)
+	}
+}
+
+func TestCacheTransport_DoesNotCacheErrors(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := (&CacheTransport{}).Client()
+	get(t, context.Background(), client, server.URL+"/rest/api/2/issueLinkType")
+	get(t, context.Background(), client, server.URL+"/rest/api/2/issueLinkType")
+	if calls != 2 {
+		t.Errorf("Expected 2 calls to Jira, got %d", calls)
+	}
+}
+
+func TestCacheTransport_SharedCache(t *testing.T) {
+	calls, otherCalls := 0, 0
+	server := testCacheServer(`[{"id":"1","name":"Blocker"}]`, &calls)
+	defer server.Close()
+	otherServer := testCacheServer(`[{"id":"2","name":"Trivial"}]`, &otherCalls)
+	defer otherServer.Close()
+
+	cache := NewLRUCache(DefaultCacheSize)
+	client := (&CacheTransport{Cache: cache}).Client()
+	otherClient := (&CacheTransport{Cache: cache}).Client()
+	for i := 0; i < 2; i++ {
+		_, body := get(t, context.Background(), client, server.URL+"/rest/api/2/priority")
+		_, otherBody := get(t, context.Background(), otherClient, otherServer.URL+"/rest/api/2/priority")
+		if body != `[{"id":"1","name":"Blocker"}]` || otherBody != `[{"id":"2","name":"Trivial"}]` {
+			t.Errorf("Expected the priorities of each instance, got %s and %s", body, otherBody)
+		}
+	}
+	if calls != 1 || otherCalls != 1 {
+		t.Errorf("Expected 1 call per instance, got %d and %d", calls, otherCalls)
+	}
+}
+
+func TestCacheTransport_Partition(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, _, _ := r.BasicAuth()
+		fmt.Fprintf(w, `{"projects":[{"key":"%s"}]}`, user)
+	}))
+	defer server.Close()
+
+	cache := NewLRUCache(DefaultCacheSize)
+	client := func(user string) *http.Client {
+		return (&CacheTransport{Cache: cache, Partition: user, Transport: basicAuth{user}}).Client()
+	}
+	url := server.URL + "/rest/api/2/issue/createmeta"
+	alice, bob := client("alice"), client("bob")
+	for i := 0; i < 2; i++ {
+		_, aliceBody := get(t, context.Background(), alice, url)
+		_, bobBody := get(t, context.Background(), bob, url)
+		if aliceBody != `{"projects":[{"key":"alice"}]}` || bobBody != `{"projects":[{"key":"bob"}]}` {
+			t.Errorf("Expected the create meta of each user, got %s and %s", aliceBody, bobBody)
+		}
+	}
+	if cache.Len() != 2 {
+		t.Errorf("Expected 1 entry per partition, got %d", cache.Len())
+	}
+}
+
+// basicAuth authenticates requests as user.
+type basicAuth struct {
+	user string
+}
+
+func (a basicAuth) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = CloneRequest(req)
+	req.SetBasicAuth(a.user, "secret")
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestMatchesEndpoint(t *testing.T) {
+	tests := []struct {
+		path     string
+		endpoint string
+		want     bool
+	}{
+		{"/rest/api/2/status", "rest/api/2/status", true},
+		{"/jira/rest/api/2/status", "rest/api/2/status", true},
+		{"/rest/api/2/status/1", "rest/api/2/status", true},
+		{"/rest/api/2/statuscategory", "rest/api/2/status", false},
+		{"/rest/api/2/issue/createmeta/EX/issuetypes", "rest/api/2/issue/createmeta", true},
+		{"/rest/api/2/issue/EX-1", "rest/api/2/issue/createmeta", false},
+	}
+
+	for _, tt := range tests {
+		if got := matchesEndpoint(tt.path, tt.endpoint); got != tt.want {
+			t.Errorf("matchesEndpoint(%q, %q) = %v, want %v", tt.path, tt.endpoint, got, tt.want)
+		}
+	}
+}
+
+func TestCacheTransport_transport(t *testing.T) {
+	// default transport
+	tp := &CacheTransport{}
+	if tp.transport() != http.DefaultTransport {
+		t.Errorf("Expected http.DefaultTransport to be used.")
+	}
+
+	// custom transport
+	tp = &CacheTransport{
+		Transport: &http.Transport{},
+	}
+	if tp.transport() == http.DefaultTransport {
+		t.Errorf("Expected custom transport to be used.")
+	}
+}
@@ -0,0 +1,8 @@
+// Package core contains the machinery shared by the Jira Cloud (cloud)
+// and Jira Server / Data Center (onpremise) clients:
+// Building requests, sending them, decoding responses and errors,
+// paging information as well as the (un)marshalling of Jira specific types.
+//
+// The public packages are thin, product specific layers on top of this package.
+// Behaviour that should be identical for both products belongs here.
+package core
@@ -0,0 +1,134 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WorkingTime is the working time configuration of the time tracking,
+// which defines the length of the units "d" and "w" of Jira durations.
+type WorkingTime struct {
+	HoursPerDay float64
+	DaysPerWeek float64
+	// DefaultUnit of numbers without a unit: "minute", "hour", "day" or "week". Default: "minute".
+	DefaultUnit string
+}
+
+// durationPartPattern matches one part of a Jira duration, e.g. "3h" or "1.5d".
+var durationPartPattern = regexp.MustCompile(`(\d+(?:[.,]\d+)?)\s*([wdhm]?)`)
+
+// units returns the length of a day and of a week in minutes.
+// Missing values default to Jira's default of 8 hours a day and 5 days a week.
+func (wt WorkingTime) units() (day, week float64) {
+	hours, days := wt.HoursPerDay, wt.DaysPerWeek
+	if hours <= 0 {
+		hours = 8
+	}
+	if days <= 0 {
+		days = 5
+	}
+	return hours * 60, days * hours * 60
+}
+
+// ParseDuration parses a Jira duration like "1w 2d 3h 30m", "1.5h" or "90" (in DefaultUnit).
+// A leading "-" negates the duration, like in the output of FormatDuration.
+func ParseDuration(s string, wt WorkingTime) (time.Duration, error) {
+	day, week := wt.units()
+	defaultUnit := map[string]string{"minute": "m", "hour": "h", "day": "d", "week": "w"}[strings.ToLower(wt.DefaultUnit)]
+	if defaultUnit == "" {
+		defaultUnit = "m"
+	}
+	return parseDuration(s, map[string]float64{"m": 1, "h": 60, "d": day, "w": week}, defaultUnit)
+}
+
+// ParseHoursMinutes parses a Jira duration in hours and minutes like "11h 30m", the output of FormatHoursMinutes.
+// Days, weeks and numbers without a unit are rejected, as they depend on the working time configuration.
+func ParseHoursMinutes(s string) (time.Duration, error) {
+	return parseDuration(s, map[string]float64{"m": 1, "h": 60}, "")
+}
+
+// parseDuration parses s with the minutes per unit.
+// Numbers without a unit are in defaultUnit, or rejected if defaultUnit is empty.
+func parseDuration(s string, unitMinutes map[string]float64, defaultUnit string) (time.Duration, error) {
+	input := strings.ToLower(strings.TrimSpace(s))
+	sign := 1.0
+	if strings.HasPrefix(input, "-") {
+		sign = -1
+		input = input[1:]
+	}
+	if input == "" {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+
+	var minutes float64
+	end := 0
+	for _, m := range durationPartPattern.FindAllStringSubmatchIndex(input, -1) {
+		if strings.TrimSpace(input[end:m[0]]) != "" {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		end = m[1]
+
+		value, err := strconv.ParseFloat(strings.Replace(input[m[2]:m[3]], ",", ".", 1), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+		}
+		unit := input[m[4]:m[5]]
+		if unit == "" {
+			unit = defaultUnit
+		}
+		perUnit, ok := unitMinutes[unit]
+		if !ok {
+			return 0, fmt.Errorf("invalid duration %q: unsupported unit %q", s, input[m[2]:m[5]])
+		}
+		minutes += value * perUnit
+	}
+	if end == 0 || strings.TrimSpace(input[end:]) != "" {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+
+	return time.Duration(math.Round(sign*minutes*60)) * time.Second, nil
+}
+
+// FormatDuration formats d as Jira duration like "1w 2d 3h 30m", rounded to minutes.
+func FormatDuration(d time.Duration, wt WorkingTime) string {
+	day, week := wt.units()
+	return formatDuration(d, []durationUnit{{"w", week}, {"d", day}, {"h", 60}, {"m", 1}})
+}
+
+// FormatHoursMinutes formats d as Jira duration in hours and minutes like "11h 30m", rounded to minutes.
+// Unlike days and weeks, hours and minutes do not depend on the working time configuration.
+func FormatHoursMinutes(d time.Duration) string {
+	return formatDuration(d, []durationUnit{{"h", 60}, {"m", 1}})
+}
+
+type durationUnit struct {
+	name    string
+	minutes float64
+}
+
+func formatDuration(d time.Duration, units []durationUnit) string {
+	minutes := math.Round(d.Minutes())
+	sign := ""
+	if minutes < 0 {
+		sign = "-"
+		minutes = -minutes
+	}
+
+	var parts []string
+	for _, u := range units {
+		// The epsilon guards against rounding errors of working days like 7.33 hours
+		n := math.Floor(minutes/u.minutes + 1e-9)
+		if n > 0 {
+			parts = append(parts, strconv.FormatFloat(n, 'f', 0, 64)+u.name)
+			minutes -= n * u.minutes
+		}
+	}
+	if len(parts) == 0 {
+		return "0m"
+	}
+	return sign + strings.Join(parts, " ")
+}
@@ -0,0 +1,89 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	wt := WorkingTime{HoursPerDay: 8, DaysPerWeek: 5}
+	tests := []struct {
+		in   string
+		wt   WorkingTime
+		want time.Duration
+	}{
+		{"1w 2d 3h 30m", wt, (40+16+3)*time.Hour + 30*time.Minute},
+		{"1w2d", wt, 56 * time.Hour},
+		{"1.5h", wt, 90 * time.Minute},
+		{"1,5h", wt, 90 * time.Minute},
+		{"45", wt, 45 * time.Minute},
+		{"2", WorkingTime{HoursPerDay: 8, DaysPerWeek: 5, DefaultUnit: "hour"}, 2 * time.Hour},
+		{"1d", WorkingTime{HoursPerDay: 7.5, DaysPerWeek: 4}, 7*time.Hour + 30*time.Minute},
+		{"1w", WorkingTime{HoursPerDay: 7.5, DaysPerWeek: 4}, 30 * time.Hour},
+		{"1d", WorkingTime{}, 8 * time.Hour},
+		{"-1h 30m", wt, -90 * time.Minute},
+	}
+	for _, tt := range tests {
+		got, err := ParseDuration(tt.in, tt.wt)
+		if err != nil {
+			t.Errorf("ParseDuration(%q) returned error %s", tt.in, err)
+		} else if got != tt.want {
+			t.Errorf("ParseDuration(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseDuration_Invalid(t *testing.T) {
+	for _, in := range []string{"", "-", "h", "1x", "1h foo", "foo 1h", "--1h"} {
+		if _, err := ParseDuration(in, WorkingTime{}); err == nil {
+			t.Errorf("ParseDuration(%q) expected an error", in)
+		}
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	wt := WorkingTime{HoursPerDay: 8, DaysPerWeek: 5}
+	tests := []struct {
+		in   time.Duration
+		wt   WorkingTime
+		want string
+	}{
+		{(40+16+3)*time.Hour + 30*time.Minute, wt, "1w 2d 3h 30m"},
+		{8 * time.Hour, wt, "1d"},
+		{0, wt, "0m"},
+		{29 * time.Second, wt, "0m"},
+		{-90 * time.Minute, wt, "-1h 30m"},
+		{15 * time.Hour, WorkingTime{HoursPerDay: 7.5, DaysPerWeek: 5}, "2d"},
+	}
+	for _, tt := range tests {
+		if got := FormatDuration(tt.in, tt.wt); got != tt.want {
+			t.Errorf("FormatDuration(%s) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestDuration_RoundTrip(t *testing.T) {
+	wt := WorkingTime{HoursPerDay: 7.5, DaysPerWeek: 5}
+	for _, d := range []time.Duration{0, 90 * time.Minute, -90 * time.Minute, 45*time.Hour + 15*time.Minute, -8 * time.Hour} {
+		if got, err := ParseDuration(FormatDuration(d, wt), wt); err != nil || got != d {
+			t.Errorf("ParseDuration(FormatDuration(%s)) = %s, %v", d, got, err)
+		}
+		if got, err := ParseHoursMinutes(FormatHoursMinutes(d)); err != nil || got != d {
+			t.Errorf("ParseHoursMinutes(FormatHoursMinutes(%s)) = %s, %v", d, got, err)
+		}
+	}
+}
+
+func TestParseHoursMinutes_Invalid(t *testing.T) {
+	for _, in := range []string{"1d", "1w 2h", "90", ""} {
+		if _, err := ParseHoursMinutes(in); err == nil {
+			t.Errorf("ParseHoursMinutes(%q) expected an error", in)
+		}
+	}
+}
+
+func TestFormatHoursMinutes(t *testing.T) {
+	if got, want := FormatHoursMinutes(59*time.Hour+30*time.Minute), "59h 30m"; got != want {
+		t.Errorf("FormatHoursMinutes = %q, want %q", got, want)
+	}
+}
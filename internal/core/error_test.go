@@ -0,0 +1,56 @@
+package core
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func newErrorResponse(contentType, body string) *Response {
+	header := make(http.Header)
+	header.Set("Content-Type", contentType)
+	return &Response{Response: &http.Response{
+		Status:     "400 Bad Request",
+		StatusCode: http.StatusBadRequest,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}}
+}
+
+func TestNewError_JSON(t *testing.T) {
+	resp := newErrorResponse("application/json;charset=UTF-8", `{"errorMessages":["Issue does not exist"],"errors":{}}`)
+
+	err := NewError(resp, errors.New("Original http error"))
+	jerr, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Expected an *Error, got %T", err)
+	}
+	if want := "Issue does not exist: Original http error"; jerr.Error() != want {
+		t.Errorf("Error() is %q, want %q", jerr.Error(), want)
+	}
+	if !strings.Contains(jerr.LongError(), " - Issue does not exist") {
+		t.Errorf("LongError() is missing the message: %q", jerr.LongError())
+	}
+}
+
+func TestNewError_NonJSON(t *testing.T) {
+	resp := newErrorResponse("text/html", "<html>oops</html>")
+
+	err := NewError(resp, errors.New("Original http error"))
+	if _, ok := err.(*Error); ok {
+		t.Fatal("Expected a plain error for a non JSON body")
+	}
+	if !strings.Contains(err.Error(), "<html>oops</html>") {
+		t.Errorf("Expected the body in the error, got %q", err)
+	}
+}
+
+func TestNewError_NilResponse(t *testing.T) {
+	httpErr := errors.New("Original http error")
+	err := NewError(nil, httpErr)
+	if !errors.Is(err, httpErr) {
+		t.Errorf("Expected the http error to be wrapped, got %v", err)
+	}
+}
@@ -0,0 +1,63 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/fatih/structs"
+	"github.com/trivago/tgo/tcontainer"
+)
+
+// UnknownsField is the name of the struct field that holds
+// all JSON attributes without a dedicated struct field, like Jira custom fields.
+const UnknownsField = "Unknowns"
+
+// MarshalWithUnknowns encodes the struct v as JSON.
+// The entries of its Unknowns field are shifted one level up,
+// so that they are encoded next to the regular fields.
+func MarshalWithUnknowns(v interface{}) ([]byte, error) {
+	m := structs.Map(v)
+	unknowns, okay := m[UnknownsField]
+	if okay {
+		// if unknowns present, shift all key value from unknown to a level up
+		for key, value := range unknowns.(tcontainer.MarshalMap) {
+			m[key] = value
+		}
+		delete(m, UnknownsField)
+	}
+	return json.Marshal(m)
+}
+
+// UnknownFields returns all attributes of the JSON object data
+// that are not mapped to a field of the struct type t via a json tag.
+func UnknownFields(data []byte, t reflect.Type) (tcontainer.MarshalMap, error) {
+	totalMap := tcontainer.NewMarshalMap()
+	err := json.Unmarshal(data, &totalMap)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tagDetail := field.Tag.Get("json")
+		if tagDetail == "" {
+			// ignore if there are no tags
+			continue
+		}
+		options := strings.Split(tagDetail, ",")
+
+		if len(options) == 0 {
+			return nil, fmt.Errorf("no tags options found for %s", field.Name)
+		}
+		// the first one is the json tag
+		key := options[0]
+		if _, okay := totalMap.Value(key); okay {
+			delete(totalMap, key)
+		}
+	}
+
+	// all the tags found in the struct were removed. Whatever is left are unknowns to struct
+	return totalMap, nil
+}
@@ -0,0 +1,47 @@
+package core
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/trivago/tgo/tcontainer"
+)
+
+type testFields struct {
+	Summary  string   `json:"summary,omitempty" structs:"summary,omitempty"`
+	Labels   []string `json:"labels,omitempty" structs:"labels,omitempty"`
+	Unknowns tcontainer.MarshalMap
+}
+
+func TestMarshalWithUnknowns(t *testing.T) {
+	f := &testFields{
+		Summary:  "Bug",
+		Unknowns: tcontainer.MarshalMap{"customfield_10010": "value"},
+	}
+
+	b, err := MarshalWithUnknowns(f)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	var got map[string]interface{}
+	json.Unmarshal(b, &got)
+	want := map[string]interface{}{"summary": "Bug", "customfield_10010": "value"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MarshalWithUnknowns returned %v, want %v", got, want)
+	}
+}
+
+func TestUnknownFields(t *testing.T) {
+	data := []byte(`{"summary":"Bug","labels":["a"],"customfield_10010":"value"}`)
+
+	got, err := UnknownFields(data, reflect.TypeOf(testFields{}))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := tcontainer.MarshalMap{"customfield_10010": "value"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("UnknownFields returned %v, want %v", got, want)
+	}
+}
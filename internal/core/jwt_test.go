@@ -0,0 +1,29 @@
+package core
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCanonicalizeRequest(t *testing.T) {
+	u, _ := url.Parse("https://jira.example.com/rest/api/2/search/?jql=project%20%3D%20EX&expand=names&jwt=token")
+
+	got := CanonicalizeRequest("get", u)
+	if want := "GET&/rest/api/2/search&expand=names&jql=project%20%3D%20EX"; got != want {
+		t.Errorf("CanonicalizeRequest returned %q, want %q", got, want)
+	}
+}
+
+func TestQueryStringHash(t *testing.T) {
+	u, _ := url.Parse("https://jira.example.com/rest/api/2/issue/EX-1?jwt=token")
+
+	// sha256("GET&/rest/api/2/issue/EX-1&")
+	got := QueryStringHash("GET", u)
+	if len(got) != 64 {
+		t.Fatalf("Expected a hex encoded sha256 hash, got %q", got)
+	}
+	u2, _ := url.Parse("https://jira.example.com/rest/api/2/issue/EX-1")
+	if QueryStringHash("GET", u2) != got {
+		t.Error("Expected the jwt parameter to be ignored")
+	}
+}
@@ -0,0 +1,130 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/google/go-querystring/query"
+)
+
+// ResolveURL resolves urlStr relative to baseURL.
+// Relative URLs should be specified without a preceding slash since baseURL will have the trailing slash.
+// A preceding slash is removed, so that the path of baseURL is preserved.
+func ResolveURL(baseURL *url.URL, urlStr string) (*url.URL, error) {
+	rel, err := url.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+	rel.Path = strings.TrimLeft(rel.Path, "/")
+
+	return baseURL.ResolveReference(rel), nil
+}
+
+// NewRawRequest creates an API request.
+// A relative URL can be provided in urlStr, in which case it is resolved relative to baseURL.
+// Allows using an optional native io.Reader for sourcing the request body.
+func NewRawRequest(ctx context.Context, baseURL *url.URL, method, urlStr string, body io.Reader) (*http.Request, error) {
+	u, err := ResolveURL(baseURL, urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	return req, nil
+}
+
+// NewRequest creates an API request.
+// A relative URL can be provided in urlStr, in which case it is resolved relative to baseURL.
+// If specified, the value pointed to by body is JSON encoded and included as the request body.
+func NewRequest(ctx context.Context, baseURL *url.URL, method, urlStr string, body interface{}) (*http.Request, error) {
+	u, err := ResolveURL(baseURL, urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf io.ReadWriter
+	if body != nil {
+		buf = new(bytes.Buffer)
+		err = json.NewEncoder(buf).Encode(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	return req, nil
+}
+
+// NewMultiPartRequest creates an API request including a multi-part file.
+// A relative URL can be provided in urlStr, in which case it is resolved relative to baseURL.
+// If specified, the value pointed to by buf is a multipart form.
+func NewMultiPartRequest(ctx context.Context, baseURL *url.URL, method, urlStr string, buf *bytes.Buffer) (*http.Request, error) {
+	u, err := ResolveURL(baseURL, urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set required headers
+	req.Header.Set("X-Atlassian-Token", "nocheck")
+
+	return req, nil
+}
+
+// AddOptions adds the parameters in opts as URL query parameters to s. opts
+// must be a struct whose fields may contain "url" tags.
+func AddOptions(s string, opts interface{}) (string, error) {
+	v := reflect.ValueOf(opts)
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return s, nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return s, err
+	}
+
+	qs, err := query.Values(opts)
+	if err != nil {
+		return s, err
+	}
+
+	u.RawQuery = qs.Encode()
+	return u.String(), nil
+}
+
+// CloneRequest returns a clone of the provided *http.Request.
+// The clone is a shallow copy of the struct and its Header map.
+func CloneRequest(r *http.Request) *http.Request {
+	// shallow copy of the struct
+	r2 := new(http.Request)
+	*r2 = *r
+	// deep copy of the Header
+	r2.Header = make(http.Header, len(r.Header))
+	for k, s := range r.Header {
+		r2.Header[k] = append([]string(nil), s...)
+	}
+	return r2
+}
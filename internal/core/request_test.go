@@ -0,0 +1,122 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func mustParseURL(t *testing.T, s string) *url.URL {
+	t.Helper()
+	u, err := url.Parse(s)
+	if err != nil {
+		t.Fatalf("url.Parse(%q) returned error: %v", s, err)
+	}
+	return u
+}
+
+func TestNewRequest(t *testing.T) {
+	baseURL := mustParseURL(t, "https://jira.example.com/jira/")
+
+	req, err := NewRequest(context.Background(), baseURL, http.MethodPost, "/rest/api/2/issue", struct {
+		A string `json:"a"`
+	}{A: "b"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	if want := "https://jira.example.com/jira/rest/api/2/issue"; req.URL.String() != want {
+		t.Errorf("URL is %v, want %v", req.URL, want)
+	}
+	if got := req.Header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type is %q, want application/json", got)
+	}
+	body, _ := io.ReadAll(req.Body)
+	if want := "{\"a\":\"b\"}\n"; string(body) != want {
+		t.Errorf("Body is %q, want %q", body, want)
+	}
+}
+
+func TestNewRequest_NilBody(t *testing.T) {
+	baseURL := mustParseURL(t, "https://jira.example.com/")
+
+	req, err := NewRequest(context.Background(), baseURL, http.MethodGet, "rest/api/2/field", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if req.Body != nil {
+		t.Errorf("Expected no body, got %v", req.Body)
+	}
+}
+
+func TestNewRequest_BadURL(t *testing.T) {
+	baseURL := mustParseURL(t, "https://jira.example.com/")
+
+	if _, err := NewRequest(context.Background(), baseURL, http.MethodGet, ":", nil); err == nil {
+		t.Error("Expected an error for an invalid URL")
+	}
+}
+
+func TestNewRawRequest(t *testing.T) {
+	baseURL := mustParseURL(t, "https://jira.example.com/")
+
+	req, err := NewRawRequest(context.Background(), baseURL, http.MethodPut, "rest/api/2/issue/EX-1", strings.NewReader("raw"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	body, _ := io.ReadAll(req.Body)
+	if string(body) != "raw" {
+		t.Errorf("Body is %q, want raw", body)
+	}
+}
+
+func TestNewMultiPartRequest(t *testing.T) {
+	baseURL := mustParseURL(t, "https://jira.example.com/")
+
+	req, err := NewMultiPartRequest(context.Background(), baseURL, http.MethodPost, "rest/api/2/issue/EX-1/attachments", bytes.NewBufferString("file"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got := req.Header.Get("X-Atlassian-Token"); got != "nocheck" {
+		t.Errorf("X-Atlassian-Token is %q, want nocheck", got)
+	}
+}
+
+func TestAddOptions(t *testing.T) {
+	type options struct {
+		StartAt int    `url:"startAt,omitempty"`
+		Expand  string `url:"expand,omitempty"`
+	}
+
+	got, err := AddOptions("rest/api/2/search", &options{StartAt: 50, Expand: "names"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if want := "rest/api/2/search?expand=names&startAt=50"; got != want {
+		t.Errorf("AddOptions returned %q, want %q", got, want)
+	}
+
+	got, err = AddOptions("rest/api/2/search", (*options)(nil))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if want := "rest/api/2/search"; got != want {
+		t.Errorf("AddOptions returned %q, want %q", got, want)
+	}
+}
+
+func TestCloneRequest(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "https://jira.example.com/", nil)
+	req.Header.Set("X-Test", "a")
+
+	clone := CloneRequest(req)
+	clone.Header.Set("X-Test", "b")
+
+	if got := req.Header.Get("X-Test"); got != "a" {
+		t.Errorf("Original header modified to %q", got)
+	}
+}
@@ -0,0 +1,14 @@
+package core
+
+import (
+	"context"
+	"net/http"
+)
+
+// Requester creates and sends API requests.
+// It is implemented by the clients of both products,
+// so that the endpoints shared by the products are implemented once.
+type Requester interface {
+	NewRequest(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error)
+	Do(req *http.Request, v interface{}) (*Response, error)
+}
@@ -0,0 +1,53 @@
+package core
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// testRequester is a Requester sending the requests to a test server.
+type testRequester struct {
+	baseURL *url.URL
+}
+
+func (c *testRequester) NewRequest(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
+	return NewRequest(ctx, c.baseURL, method, urlStr, body)
+}
+
+func (c *testRequester) Do(req *http.Request, v interface{}) (*Response, error) {
+	return Do(http.DefaultClient, req, v)
+}
+
+// newTestRequester starts a test server serving mux and returns a Requester for it.
+func newTestRequester(t *testing.T) (*testRequester, *http.ServeMux) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	baseURL, _ := url.Parse(server.URL + "/")
+	return &testRequester{baseURL: baseURL}, mux
+}
+
+func testMethod(t *testing.T, r *http.Request, want string) {
+	if got := r.Method; got != want {
+		t.Errorf("Request method: %v, want %v", got, want)
+	}
+}
+
+func testRequestURL(t *testing.T, r *http.Request, want string) {
+	if got := r.URL.String(); !strings.HasPrefix(got, want) {
+		t.Errorf("Request URL: %v, want %v", got, want)
+	}
+}
+
+func testRequestBody(t *testing.T, r *http.Request, want string) {
+	b, _ := io.ReadAll(r.Body)
+	if got := strings.TrimSpace(string(b)); got != want {
+		t.Errorf("Request body: %s, want %s", got, want)
+	}
+}
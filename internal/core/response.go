@@ -0,0 +1,89 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Response represents Jira API response. It wraps http.Response returned from
+// API and provides information about paging.
+type Response struct {
+	*http.Response
+
+	StartAt    int
+	MaxResults int
+	Total      int
+
+	// Cursor based paging, e.g. of the Jira Cloud search
+	IsLast        bool
+	NextPageToken string
+}
+
+// Page carries the paging information of a single API result.
+type Page struct {
+	StartAt    int
+	MaxResults int
+	Total      int
+
+	IsLast        bool
+	NextPageToken string
+}
+
+// Pager is implemented by API result wrappers that carry paging information.
+// If the value decoded by Do implements Pager, its Page is copied into the Response.
+type Pager interface {
+	Page() Page
+}
+
+// NewResponse wraps r and sets the paging values of v, if v implements Pager.
+func NewResponse(r *http.Response, v interface{}) *Response {
+	resp := &Response{Response: r}
+	if p, ok := v.(Pager); ok {
+		page := p.Page()
+		resp.StartAt = page.StartAt
+		resp.MaxResults = page.MaxResults
+		resp.Total = page.Total
+		resp.IsLast = page.IsLast
+		resp.NextPageToken = page.NextPageToken
+	}
+	return resp
+}
+
+// Do sends an API request via client and returns the API response.
+// The API response is JSON decoded and stored in the value pointed to by v, or returned as an error if an API error has occurred.
+func Do(client *http.Client, req *http.Request, v interface{}) (*Response, error) {
+	httpResp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = CheckResponse(httpResp)
+	if err != nil {
+		// Even though there was an error, we still return the response
+		// in case the caller wants to inspect it further
+		return NewResponse(httpResp, nil), err
+	}
+
+	if v != nil {
+		// Open a NewDecoder and defer closing the reader only if there is a provided interface to decode to
+		defer httpResp.Body.Close()
+		err = json.NewDecoder(httpResp.Body).Decode(v)
+	}
+
+	resp := NewResponse(httpResp, v)
+	return resp, err
+}
+
+// CheckResponse checks the API response for errors, and returns them if present.
+// A response is considered an error if it has a status code outside the 200 range.
+// The caller is responsible to analyze the response body.
+// The body can contain JSON (if the error is intended) or xml (sometimes Jira just failes).
+func CheckResponse(r *http.Response) error {
+	if c := r.StatusCode; 200 <= c && c <= 299 {
+		return nil
+	}
+
+	err := fmt.Errorf("request failed. Please analyze the request body for more details. Status code: %d", r.StatusCode)
+	return err
+}
@@ -0,0 +1,77 @@
+package core
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type pagedResult struct {
+	StartAt       int    `json:"startAt"`
+	MaxResults    int    `json:"maxResults"`
+	Total         int    `json:"total"`
+	IsLast        bool   `json:"isLast"`
+	NextPageToken string `json:"nextPageToken"`
+}
+
+func (r *pagedResult) Page() Page {
+	return Page{StartAt: r.StartAt, MaxResults: r.MaxResults, Total: r.Total, IsLast: r.IsLast, NextPageToken: r.NextPageToken}
+}
+
+func TestDo_PopulatesPageValues(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"startAt":1,"maxResults":10,"total":3,"isLast":true,"nextPageToken":"abc"}`)
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	result := new(pagedResult)
+	resp, err := Do(server.Client(), req, result)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	if resp.StartAt != 1 || resp.MaxResults != 10 || resp.Total != 3 {
+		t.Errorf("Unexpected offset paging values: %+v", resp)
+	}
+	if !resp.IsLast || resp.NextPageToken != "abc" {
+		t.Errorf("Unexpected cursor paging values: %+v", resp)
+	}
+}
+
+func TestDo_HTTPError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	resp, err := Do(server.Client(), req, nil)
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected the response to be returned, got %+v", resp)
+	}
+}
+
+func TestNewResponse_NoPager(t *testing.T) {
+	resp := NewResponse(&http.Response{}, &struct{ Total int }{Total: 5})
+	if resp.Total != 0 {
+		t.Errorf("Expected no paging values, got %+v", resp)
+	}
+}
+
+func TestCheckResponse(t *testing.T) {
+	for _, code := range []int{200, 204, 299} {
+		if err := CheckResponse(&http.Response{StatusCode: code}); err != nil {
+			t.Errorf("CheckResponse(%d) returned error: %v", code, err)
+		}
+	}
+	for _, code := range []int{199, 300, 404, 500} {
+		if err := CheckResponse(&http.Response{StatusCode: code}); err == nil {
+			t.Errorf("CheckResponse(%d) returned no error", code)
+		}
+	}
+}
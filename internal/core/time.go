@@ -0,0 +1,50 @@
+package core
+
+import "time"
+
+const (
+	// timeLayout is the layout of Jira timestamps, e.g. "2016-01-20T12:13:05.000+0100".
+	timeLayout = "\"2006-01-02T15:04:05.000-0700\""
+	// timeParseLayout also accepts timestamps without (or with shortened) fractional seconds.
+	timeParseLayout = "\"2006-01-02T15:04:05.999-0700\""
+	// dateLayout is the layout of Jira dates, e.g. "2016-01-20".
+	dateLayout = "\"2006-01-02\""
+)
+
+// UnmarshalTime parses a JSON encoded Jira timestamp.
+// ok is false if b is null, in which case the target should be left untouched.
+func UnmarshalTime(b []byte) (t time.Time, ok bool, err error) {
+	// Ignore null, like in the main JSON package.
+	if string(b) == "null" {
+		return time.Time{}, false, nil
+	}
+	t, err = time.Parse(timeParseLayout, string(b))
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return t, true, nil
+}
+
+// MarshalTime encodes t as a JSON Jira timestamp.
+func MarshalTime(t time.Time) []byte {
+	return []byte(t.Format(timeLayout))
+}
+
+// UnmarshalDate parses a JSON encoded Jira date.
+// ok is false if b is null, in which case the target should be left untouched.
+func UnmarshalDate(b []byte) (t time.Time, ok bool, err error) {
+	// Ignore null, like in the main JSON package.
+	if string(b) == "null" {
+		return time.Time{}, false, nil
+	}
+	t, err = time.Parse(dateLayout, string(b))
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return t, true, nil
+}
+
+// MarshalDate encodes t as a JSON Jira date.
+func MarshalDate(t time.Time) []byte {
+	return []byte(t.Format(dateLayout))
+}
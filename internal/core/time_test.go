@@ -0,0 +1,52 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTime_RoundTrip(t *testing.T) {
+	in := []byte(`"2016-01-20T12:13:05.123+0100"`)
+
+	ti, ok, err := UnmarshalTime(in)
+	if err != nil || !ok {
+		t.Fatalf("UnmarshalTime returned %v, %v", ok, err)
+	}
+	if got := string(MarshalTime(ti)); got != string(in) {
+		t.Errorf("MarshalTime returned %s, want %s", got, in)
+	}
+}
+
+func TestUnmarshalTime_ShortFraction(t *testing.T) {
+	ti, ok, err := UnmarshalTime([]byte(`"2016-01-20T12:13:05+0000"`))
+	if err != nil || !ok {
+		t.Fatalf("UnmarshalTime returned %v, %v", ok, err)
+	}
+	if want := time.Date(2016, 1, 20, 12, 13, 5, 0, time.UTC); !ti.Equal(want) {
+		t.Errorf("UnmarshalTime returned %v, want %v", ti, want)
+	}
+}
+
+func TestUnmarshalTime_Null(t *testing.T) {
+	if _, ok, err := UnmarshalTime([]byte("null")); ok || err != nil {
+		t.Errorf("Expected null to be ignored, got %v, %v", ok, err)
+	}
+	if _, _, err := UnmarshalTime([]byte(`"yesterday"`)); err == nil {
+		t.Error("Expected an error for an invalid time")
+	}
+}
+
+func TestDate_RoundTrip(t *testing.T) {
+	in := []byte(`"2016-01-20"`)
+
+	d, ok, err := UnmarshalDate(in)
+	if err != nil || !ok {
+		t.Fatalf("UnmarshalDate returned %v, %v", ok, err)
+	}
+	if got := string(MarshalDate(d)); got != string(in) {
+		t.Errorf("MarshalDate returned %s, want %s", got, in)
+	}
+	if _, ok, err := UnmarshalDate([]byte("null")); ok || err != nil {
+		t.Errorf("Expected null to be ignored, got %v, %v", ok, err)
+	}
+}
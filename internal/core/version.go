@@ -0,0 +1,236 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Version represents a single release version of a project
+type Version struct {
+	Self            string `json:"self,omitempty" structs:"self,omitempty"`
+	ID              string `json:"id,omitempty" structs:"id,omitempty"`
+	Name            string `json:"name,omitempty" structs:"name,omitempty"`
+	Description     string `json:"description,omitempty" structs:"description,omitempty"`
+	Archived        *bool  `json:"archived,omitempty" structs:"archived,omitempty"`
+	Released        *bool  `json:"released,omitempty" structs:"released,omitempty"`
+	ReleaseDate     string `json:"releaseDate,omitempty" structs:"releaseDate,omitempty"`
+	UserReleaseDate string `json:"userReleaseDate,omitempty" structs:"userReleaseDate,omitempty"`
+	ProjectID       int    `json:"projectId,omitempty" structs:"projectId,omitempty"` // Unlike other IDs, this is returned as a number
+	StartDate       string `json:"startDate,omitempty" structs:"startDate,omitempty"`
+
+	// MoveUnfixedIssuesTo is the URL of the version the unresolved issues are moved to when the version is released.
+	// It is only used when releasing a version.
+	MoveUnfixedIssuesTo string `json:"moveUnfixedIssuesTo,omitempty" structs:"moveUnfixedIssuesTo,omitempty"`
+}
+
+// Version positions, see MoveVersion
+const (
+	VersionPositionFirst   = "First"
+	VersionPositionLast    = "Last"
+	VersionPositionEarlier = "Earlier"
+	VersionPositionLater   = "Later"
+)
+
+// VersionDeleteOptions specifies the optional parameters of DeleteVersion.
+type VersionDeleteOptions struct {
+	// MoveFixIssuesTo is the ID of the version replacing the deleted version as fix version.
+	MoveFixIssuesTo string `url:"moveFixIssuesTo,omitempty"`
+	// MoveAffectedIssuesTo is the ID of the version replacing the deleted version as affected version.
+	MoveAffectedIssuesTo string `url:"moveAffectedIssuesTo,omitempty"`
+}
+
+// VersionMoveOptions are passed to MoveVersion.
+// Exactly one of the fields must be set.
+type VersionMoveOptions struct {
+	// After is the URL (Version.Self) of the version to move the version after.
+	After string `json:"after,omitempty" structs:"after,omitempty"`
+	// Position is one of the VersionPosition* constants.
+	Position string `json:"position,omitempty" structs:"position,omitempty"`
+}
+
+// VersionListOptions specifies the optional parameters of ListVersionsByProject.
+type VersionListOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+
+	// OrderBy: e.g. "sequence", "-releaseDate", "name" or "startDate".
+	OrderBy string `url:"orderBy,omitempty"`
+
+	// Query: Matches the name or description of the version, case insensitive.
+	Query string `url:"query,omitempty"`
+
+	// Status: A comma separated list of "released", "unreleased" and "archived".
+	Status string `url:"status,omitempty"`
+
+	Expand string `url:"expand,omitempty"`
+}
+
+// VersionIssueCounts are the numbers of issues related to a version.
+type VersionIssueCounts struct {
+	Self                string `json:"self,omitempty" structs:"self,omitempty"`
+	IssuesFixedCount    int    `json:"issuesFixedCount" structs:"issuesFixedCount"`
+	IssuesAffectedCount int    `json:"issuesAffectedCount" structs:"issuesAffectedCount"`
+}
+
+// VersionUnresolvedIssueCount is the number of unresolved issues of a version.
+type VersionUnresolvedIssueCount struct {
+	Self                  string `json:"self,omitempty" structs:"self,omitempty"`
+	IssuesUnresolvedCount int    `json:"issuesUnresolvedCount" structs:"issuesUnresolvedCount"`
+	IssuesCount           int    `json:"issuesCount" structs:"issuesCount"`
+}
+
+// versionListResult is only a small wrapper around ListVersionsByProject
+// to be able to parse the results
+type versionListResult struct {
+	StartAt    int       `json:"startAt"`
+	MaxResults int       `json:"maxResults"`
+	Total      int       `json:"total"`
+	IsLast     bool      `json:"isLast"`
+	Values     []Version `json:"values"`
+}
+
+func (r *versionListResult) Page() Page {
+	return Page{StartAt: r.StartAt, MaxResults: r.MaxResults, Total: r.Total, IsLast: r.IsLast}
+}
+
+// DeleteVersion deletes a version.
+// The issues with the version as fix version or affected version are moved
+// to the versions set in options, otherwise the version is removed from them.
+func DeleteVersion(ctx context.Context, c Requester, versionID string, options *VersionDeleteOptions) (*Response, error) {
+	apiEndpoint, err := AddOptions(fmt.Sprintf("rest/api/2/version/%s", versionID), options)
+	if err != nil {
+		return nil, err
+	}
+	req, err := c.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Do(req, nil)
+	if err != nil {
+		return resp, NewError(resp, err)
+	}
+	return resp, nil
+}
+
+// MergeVersion merges a version into the version moveIssuesTo and deletes it.
+func MergeVersion(ctx context.Context, c Requester, versionID, moveIssuesTo string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%s/mergeto/%s", versionID, moveIssuesTo)
+	req, err := c.NewRequest(ctx, http.MethodPut, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Do(req, nil)
+	if err != nil {
+		return resp, NewError(resp, err)
+	}
+	return resp, nil
+}
+
+// MoveVersion changes the position of a version in the list of versions of its project.
+func MoveVersion(ctx context.Context, c Requester, versionID string, options *VersionMoveOptions) (*Version, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%s/move", versionID)
+	return sendVersion(ctx, c, http.MethodPost, apiEndpoint, options)
+}
+
+// ListVersionsByProject returns a page of the versions of a project.
+func ListVersionsByProject(ctx context.Context, c Requester, projectID string, options *VersionListOptions) ([]Version, *Response, error) {
+	apiEndpoint, err := AddOptions(fmt.Sprintf("rest/api/2/project/%s/version", projectID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := c.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(versionListResult)
+	resp, err := c.Do(req, result)
+	if err != nil {
+		return nil, resp, NewError(resp, err)
+	}
+	return result.Values, resp, nil
+}
+
+// GetVersionRelatedIssueCounts returns the number of issues with the version as fix version and as affected version.
+func GetVersionRelatedIssueCounts(ctx context.Context, c Requester, versionID string) (*VersionIssueCounts, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%s/relatedIssueCounts", versionID)
+	req, err := c.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	counts := new(VersionIssueCounts)
+	resp, err := c.Do(req, counts)
+	if err != nil {
+		return nil, resp, NewError(resp, err)
+	}
+	return counts, resp, nil
+}
+
+// GetVersionUnresolvedIssueCount returns the number of unresolved issues with the version as fix version.
+func GetVersionUnresolvedIssueCount(ctx context.Context, c Requester, versionID string) (*VersionUnresolvedIssueCount, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%s/unresolvedIssueCount", versionID)
+	req, err := c.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	count := new(VersionUnresolvedIssueCount)
+	resp, err := c.Do(req, count)
+	if err != nil {
+		return nil, resp, NewError(resp, err)
+	}
+	return count, resp, nil
+}
+
+// ReleaseVersion marks a version as released, like the release dialog of Jira.
+// The release date is set to today, unless the version has a ReleaseDate.
+// The unresolved issues of the version are moved to the version moveUnresolvedTo, if it isn't nil.
+// Its URL is resolved relative to baseURL if it has no Self URL.
+func ReleaseVersion(ctx context.Context, c Requester, baseURL *url.URL, version *Version, moveUnresolvedTo *Version) (*Version, *Response, error) {
+	released := true
+	release := Version{
+		Released:    &released,
+		ReleaseDate: version.ReleaseDate,
+	}
+	if release.ReleaseDate == "" {
+		release.ReleaseDate = time.Now().Format("2006-01-02")
+	}
+	if moveUnresolvedTo != nil {
+		release.MoveUnfixedIssuesTo = moveUnresolvedTo.Self
+		if release.MoveUnfixedIssuesTo == "" {
+			u := baseURL.ResolveReference(&url.URL{Path: "rest/api/2/version/" + moveUnresolvedTo.ID})
+			release.MoveUnfixedIssuesTo = u.String()
+		}
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%s", version.ID)
+	return sendVersion(ctx, c, http.MethodPut, apiEndpoint, release)
+}
+
+// ArchiveVersion marks a version as archived.
+func ArchiveVersion(ctx context.Context, c Requester, versionID string) (*Version, *Response, error) {
+	archived := true
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%s", versionID)
+	return sendVersion(ctx, c, http.MethodPut, apiEndpoint, Version{Archived: &archived})
+}
+
+// sendVersion sends body to apiEndpoint with method and returns the version of the response.
+func sendVersion(ctx context.Context, c Requester, method, apiEndpoint string, body interface{}) (*Version, *Response, error) {
+	req, err := c.NewRequest(ctx, method, apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := new(Version)
+	resp, err := c.Do(req, version)
+	if err != nil {
+		return nil, resp, NewError(resp, err)
+	}
+	return version, resp, nil
+}
@@ -0,0 +1,149 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestDeleteVersion(t *testing.T) {
+	c, mux := newTestRequester(t)
+	mux.HandleFunc("/rest/api/2/version/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, "/rest/api/2/version/10000?moveAffectedIssuesTo=10002&moveFixIssuesTo=10001")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := DeleteVersion(context.Background(), c, "10000", &VersionDeleteOptions{MoveFixIssuesTo: "10001", MoveAffectedIssuesTo: "10002"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestMergeVersion(t *testing.T) {
+	c, mux := newTestRequester(t)
+	mux.HandleFunc("/rest/api/2/version/10000/mergeto/10001", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := MergeVersion(context.Background(), c, "10000", "10001"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestMoveVersion(t *testing.T) {
+	c, mux := newTestRequester(t)
+	mux.HandleFunc("/rest/api/2/version/10000/move", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestBody(t, r, `{"position":"First"}`)
+		fmt.Fprint(w, `{"id":"10000","name":"New Version 1"}`)
+	})
+
+	version, _, err := MoveVersion(context.Background(), c, "10000", &VersionMoveOptions{Position: VersionPositionFirst})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if version.ID != "10000" {
+		t.Errorf("Unexpected version: %+v", version)
+	}
+}
+
+func TestListVersionsByProject(t *testing.T) {
+	c, mux := newTestRequester(t)
+	mux.HandleFunc("/rest/api/2/project/EX/version", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/project/EX/version?maxResults=2&orderBy=-releaseDate&status=unreleased")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":2,"total":3,"isLast":false,"values":[
+			{"id":"10000","name":"1.1","released":false},
+			{"id":"10001","name":"1.2","released":false}
+		]}`)
+	})
+
+	versions, resp, err := ListVersionsByProject(context.Background(), c, "EX", &VersionListOptions{
+		MaxResults: 2,
+		OrderBy:    "-releaseDate",
+		Status:     "unreleased",
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(versions) != 2 || versions[1].Name != "1.2" {
+		t.Errorf("Unexpected versions: %+v", versions)
+	}
+	if resp.Total != 3 || resp.IsLast {
+		t.Errorf("Unexpected paging values: %+v", resp)
+	}
+}
+
+func TestGetVersionIssueCounts(t *testing.T) {
+	c, mux := newTestRequester(t)
+	mux.HandleFunc("/rest/api/2/version/10000/relatedIssueCounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/version/10000","issuesFixedCount":23,"issuesAffectedCount":101}`)
+	})
+	mux.HandleFunc("/rest/api/2/version/10000/unresolvedIssueCount", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/version/10000","issuesUnresolvedCount":23,"issuesCount":30}`)
+	})
+
+	counts, _, err := GetVersionRelatedIssueCounts(context.Background(), c, "10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if counts.IssuesFixedCount != 23 || counts.IssuesAffectedCount != 101 {
+		t.Errorf("Unexpected counts: %+v", counts)
+	}
+
+	unresolved, _, err := GetVersionUnresolvedIssueCount(context.Background(), c, "10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if unresolved.IssuesUnresolvedCount != 23 || unresolved.IssuesCount != 30 {
+		t.Errorf("Unexpected count: %+v", unresolved)
+	}
+}
+
+func TestReleaseVersion(t *testing.T) {
+	c, mux := newTestRequester(t)
+	mux.HandleFunc("/rest/api/2/version/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestBody(t, r, `{"released":true,"releaseDate":"2026-10-01","moveUnfixedIssuesTo":"https://example.com/rest/api/2/version/10001"}`)
+		fmt.Fprint(w, `{"id":"10000","name":"1.1","released":true}`)
+	})
+
+	version, _, err := ReleaseVersion(context.Background(), c, c.baseURL, &Version{ID: "10000", Name: "1.1", ReleaseDate: "2026-10-01"}, &Version{ID: "10001", Self: "https://example.com/rest/api/2/version/10001"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if version.Released == nil || !*version.Released {
+		t.Errorf("Expected the version to be released: %+v", version)
+	}
+}
+
+func TestReleaseVersion_Today(t *testing.T) {
+	c, mux := newTestRequester(t)
+	mux.HandleFunc("/rest/api/2/version/10000", func(w http.ResponseWriter, r *http.Request) {
+		testRequestBody(t, r, fmt.Sprintf(`{"released":true,"releaseDate":%q}`, time.Now().Format("2006-01-02")))
+		fmt.Fprint(w, `{"id":"10000","released":true}`)
+	})
+
+	if _, _, err := ReleaseVersion(context.Background(), c, c.baseURL, &Version{ID: "10000"}, nil); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestArchiveVersion(t *testing.T) {
+	c, mux := newTestRequester(t)
+	mux.HandleFunc("/rest/api/2/version/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestBody(t, r, `{"archived":true}`)
+		fmt.Fprint(w, `{"id":"10000","archived":true}`)
+	})
+
+	if _, _, err := ArchiveVersion(context.Background(), c, "10000"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,238 @@
+package core
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WebhookSignatureHeader is the header carrying the HMAC signature of a webhook payload,
+// e.g. "sha256=5c2f...".
+const WebhookSignatureHeader = "X-Hub-Signature"
+
+// maxWebhookPayloadSize limits the size of accepted webhook payloads.
+const maxWebhookPayloadSize = 10 << 20
+
+// ErrInvalidWebhookSignature is returned if the signature of a webhook payload doesn't match the secret.
+var ErrInvalidWebhookSignature = errors.New("webhook: invalid signature")
+
+// WebhookEvent contains the attributes all webhook payloads have in common.
+// The typed events of the webhook packages embed it.
+type WebhookEvent struct {
+	// Timestamp is the time of the event in milliseconds since the Unix epoch.
+	Timestamp    int64  `json:"timestamp"`
+	WebhookEvent string `json:"webhookEvent"`
+
+	// Payload is the raw JSON payload of the event.
+	Payload json.RawMessage `json:"-"`
+}
+
+// Time returns the Timestamp as time.Time.
+func (e *WebhookEvent) Time() time.Time {
+	return time.UnixMilli(e.Timestamp)
+}
+
+// webhookEvent is promoted to all events embedding a WebhookEvent.
+func (e *WebhookEvent) webhookEvent() *WebhookEvent {
+	return e
+}
+
+// webhookEventer is implemented by all events embedding a WebhookEvent.
+type webhookEventer interface {
+	webhookEvent() *WebhookEvent
+}
+
+// WebhookKind is the kind of typed event of a webhook event type.
+type WebhookKind int
+
+// Kinds of webhook events.
+const (
+	WebhookKindOther WebhookKind = iota
+	WebhookKindIssue
+	WebhookKindComment
+	WebhookKindWorklog
+	WebhookKindSprint
+)
+
+// WebhookEventKind returns the kind of typed event of eventType, e.g. WebhookKindIssue for "jira:issue_created".
+func WebhookEventKind(eventType string) WebhookKind {
+	switch {
+	case strings.HasPrefix(eventType, "jira:issue_"):
+		return WebhookKindIssue
+	case strings.HasPrefix(eventType, "comment_"):
+		return WebhookKindComment
+	case strings.HasPrefix(eventType, "worklog_"):
+		return WebhookKindWorklog
+	case strings.HasPrefix(eventType, "sprint_"):
+		return WebhookKindSprint
+	}
+	return WebhookKindOther
+}
+
+// ParseWebhookEvent parses a webhook payload into the event returned by typed for the kind of the event type.
+// typed returns a pointer to a struct embedding WebhookEvent, or nil for events without a typed event.
+// In that case the payload is returned as *WebhookEvent.
+func ParseWebhookEvent(payload []byte, typed func(WebhookKind) interface{}) (interface{}, error) {
+	var e WebhookEvent
+	if err := json.Unmarshal(payload, &e); err != nil {
+		return nil, fmt.Errorf("webhook: parsing payload: %w", err)
+	}
+	e.Payload = payload
+
+	v := typed(WebhookEventKind(e.WebhookEvent))
+	if v == nil {
+		return &e, nil
+	}
+	if err := json.Unmarshal(payload, v); err != nil {
+		return nil, fmt.Errorf("webhook: parsing %s payload: %w", e.WebhookEvent, err)
+	}
+	if event, ok := v.(webhookEventer); ok {
+		event.webhookEvent().Payload = payload
+	}
+	return v, nil
+}
+
+// WebhookMux dispatches webhook events to the functions registered per event type.
+// The zero value is ready to use.
+type WebhookMux struct {
+	mu       sync.RWMutex
+	handlers map[string]func(context.Context, interface{}) error
+}
+
+// HandleWebhook registers f for eventType on m.
+// eventType needs to be of the kind k of the registering method, which is named in the panic otherwise.
+func HandleWebhook[E any](m *WebhookMux, method, eventType string, k WebhookKind, f func(context.Context, E) error) {
+	if WebhookEventKind(eventType) != k {
+		panic(fmt.Sprintf("webhook: %s can't handle event type %q", method, eventType))
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.handlers == nil {
+		m.handlers = make(map[string]func(context.Context, interface{}) error)
+	}
+	m.handlers[eventType] = func(ctx context.Context, e interface{}) error {
+		ev, ok := e.(E)
+		if !ok {
+			return fmt.Errorf("webhook: unexpected %T for %s", e, eventType)
+		}
+		return f(ctx, ev)
+	}
+}
+
+// WebhookOptions are the settings of a webhook endpoint served by WebhookMux.Serve.
+type WebhookOptions struct {
+	// Secret is the secret configured for the webhook.
+	// If set, the X-Hub-Signature header of every request is verified.
+	Secret string
+
+	// URLSecret is compared to the "secret" query parameter of every request, if set.
+	URLSecret string
+
+	// ErrorLog is called with errors returned by the event handlers, if set.
+	ErrorLog func(err error)
+
+	// Parse parses a payload into a typed event.
+	Parse func(payload []byte) (interface{}, error)
+}
+
+// Serve receives a webhook request and dispatches its event.
+//
+// Requests with an invalid signature or secret are answered with 401 Unauthorized,
+// invalid payloads with 400 Bad Request and handler errors with 500 Internal Server Error.
+// Events without a registered handler are acknowledged with 200 OK.
+func (m *WebhookMux) Serve(w http.ResponseWriter, r *http.Request, opts WebhookOptions) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookPayloadSize))
+	if err != nil {
+		http.Error(w, "reading payload failed", http.StatusBadRequest)
+		return
+	}
+
+	if opts.URLSecret != "" && subtle.ConstantTimeCompare([]byte(r.URL.Query().Get("secret")), []byte(opts.URLSecret)) != 1 {
+		http.Error(w, "invalid secret", http.StatusUnauthorized)
+		return
+	}
+	if opts.Secret != "" {
+		if err := VerifyWebhookSignature(opts.Secret, payload, r.Header.Get(WebhookSignatureHeader)); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+	}
+
+	event, err := opts.Parse(payload)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var eventType string
+	if e, ok := event.(webhookEventer); ok {
+		eventType = e.webhookEvent().WebhookEvent
+	}
+	m.mu.RLock()
+	f := m.handlers[eventType]
+	m.mu.RUnlock()
+	if f == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if err := f(r.Context(), event); err != nil {
+		if opts.ErrorLog != nil {
+			opts.ErrorLog(err)
+		}
+		http.Error(w, "handling event failed", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// VerifyWebhookSignature verifies the HMAC signature of a webhook payload.
+// signature is the value of the X-Hub-Signature header, e.g. "sha256=5c2f...".
+// The algorithms sha256 and sha1 are supported.
+func VerifyWebhookSignature(secret string, payload []byte, signature string) error {
+	method, sig, ok := strings.Cut(signature, "=")
+	if !ok {
+		return ErrInvalidWebhookSignature
+	}
+
+	var h func() hash.Hash
+	switch strings.ToLower(method) {
+	case "sha256":
+		h = sha256.New
+	case "sha1":
+		h = sha1.New
+	default:
+		return fmt.Errorf("webhook: unsupported signature method %q", method)
+	}
+
+	expected, err := hex.DecodeString(sig)
+	if err != nil {
+		return ErrInvalidWebhookSignature
+	}
+	mac := hmac.New(h, []byte(secret))
+	mac.Write(payload)
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return ErrInvalidWebhookSignature
+	}
+	return nil
+}
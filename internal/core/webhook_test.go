@@ -0,0 +1,187 @@
+package core
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type testIssueEvent struct {
+	WebhookEvent
+	Issue struct {
+		Key string `json:"key"`
+	} `json:"issue"`
+}
+
+func parseTestWebhookEvent(payload []byte) (interface{}, error) {
+	return ParseWebhookEvent(payload, func(k WebhookKind) interface{} {
+		if k == WebhookKindIssue {
+			return &testIssueEvent{}
+		}
+		return nil
+	})
+}
+
+func sign(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func serveWebhook(m *WebhookMux, opts WebhookOptions, target, payload string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(payload))
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	rec := httptest.NewRecorder()
+	opts.Parse = parseTestWebhookEvent
+	m.Serve(rec, req, opts)
+	return rec
+}
+
+func TestParseWebhookEvent(t *testing.T) {
+	v, err := parseTestWebhookEvent([]byte(`{"timestamp":1700000000000,"webhookEvent":"jira:issue_created","issue":{"key":"EX-1"}}`))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	e, ok := v.(*testIssueEvent)
+	if !ok {
+		t.Fatalf("Expected a *testIssueEvent, got %T", v)
+	}
+	if e.Issue.Key != "EX-1" || e.Time().UnixMilli() != 1700000000000 || len(e.Payload) == 0 {
+		t.Errorf("Unexpected event %+v", e)
+	}
+
+	v, err = parseTestWebhookEvent([]byte(`{"webhookEvent":"project_created"}`))
+	if e, ok := v.(*WebhookEvent); err != nil || !ok || e.WebhookEvent != "project_created" {
+		t.Errorf("Expected a *WebhookEvent, got %#v (%v)", v, err)
+	}
+
+	if _, err := parseTestWebhookEvent([]byte(`not json`)); err == nil {
+		t.Error("Expected an error")
+	}
+}
+
+func TestWebhookEventKind(t *testing.T) {
+	tests := map[string]WebhookKind{
+		"jira:issue_updated": WebhookKindIssue,
+		"comment_deleted":    WebhookKindComment,
+		"worklog_created":    WebhookKindWorklog,
+		"sprint_closed":      WebhookKindSprint,
+		"project_created":    WebhookKindOther,
+	}
+	for eventType, want := range tests {
+		if got := WebhookEventKind(eventType); got != want {
+			t.Errorf("Expected kind %d for %s, got %d", want, eventType, got)
+		}
+	}
+}
+
+func TestWebhookMux_Dispatch(t *testing.T) {
+	m := &WebhookMux{}
+	var issueKey string
+	HandleWebhook(m, "OnIssue", "jira:issue_updated", WebhookKindIssue, func(ctx context.Context, e *testIssueEvent) error {
+		issueKey = e.Issue.Key
+		return nil
+	})
+
+	rec := serveWebhook(m, WebhookOptions{}, "/", `{"webhookEvent":"jira:issue_updated","issue":{"key":"EX-1"}}`, nil)
+	if rec.Code != http.StatusOK || issueKey != "EX-1" {
+		t.Errorf("Expected status 200 and EX-1, got %d and %q", rec.Code, issueKey)
+	}
+
+	if rec := serveWebhook(m, WebhookOptions{}, "/", `{"webhookEvent":"project_created"}`, nil); rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200 for an unhandled event, got %d", rec.Code)
+	}
+}
+
+func TestWebhookMux_MismatchingKind(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil || !strings.Contains(r.(string), "OnIssue") {
+			t.Errorf("Expected a panic naming OnIssue, got %v", r)
+		}
+	}()
+	HandleWebhook(&WebhookMux{}, "OnIssue", "comment_created", WebhookKindIssue, func(ctx context.Context, e *testIssueEvent) error { return nil })
+}
+
+func TestWebhookMux_MismatchingEvent(t *testing.T) {
+	m := &WebhookMux{}
+	var logged error
+	HandleWebhook(m, "OnEvent", "jira:issue_created", WebhookKindIssue, func(ctx context.Context, e *WebhookEvent) error {
+		t.Error("Unexpected call with a mismatching event")
+		return nil
+	})
+
+	rec := serveWebhook(m, WebhookOptions{ErrorLog: func(err error) { logged = err }}, "/", `{"webhookEvent":"jira:issue_created"}`, nil)
+	if rec.Code != http.StatusInternalServerError || logged == nil {
+		t.Errorf("Expected status 500 and a logged error, got %d and %v", rec.Code, logged)
+	}
+}
+
+func TestWebhookMux_HandlerError(t *testing.T) {
+	m := &WebhookMux{}
+	var logged error
+	HandleWebhook(m, "OnIssue", "jira:issue_created", WebhookKindIssue, func(ctx context.Context, e *testIssueEvent) error {
+		return errors.New("boom")
+	})
+
+	rec := serveWebhook(m, WebhookOptions{ErrorLog: func(err error) { logged = err }}, "/", `{"webhookEvent":"jira:issue_created"}`, nil)
+	if rec.Code != http.StatusInternalServerError || logged == nil {
+		t.Errorf("Expected status 500 and a logged error, got %d and %v", rec.Code, logged)
+	}
+}
+
+func TestWebhookMux_Signature(t *testing.T) {
+	opts := WebhookOptions{Secret: "s3cr3t"}
+	payload := `{"webhookEvent":"sprint_started","sprint":{"id":1}}`
+
+	rec := serveWebhook(&WebhookMux{}, opts, "/", payload, http.Header{WebhookSignatureHeader: {sign("s3cr3t", payload)}})
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200 for a valid signature, got %d", rec.Code)
+	}
+
+	for _, signature := range []string{"", sign("wrong", payload), "sha256=zz", "md5=abc"} {
+		rec = serveWebhook(&WebhookMux{}, opts, "/", payload, http.Header{WebhookSignatureHeader: {signature}})
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected status 401 for signature %q, got %d", signature, rec.Code)
+		}
+	}
+}
+
+func TestWebhookMux_URLSecret(t *testing.T) {
+	opts := WebhookOptions{URLSecret: "s3cr3t"}
+	payload := `{"webhookEvent":"sprint_started"}`
+
+	if rec := serveWebhook(&WebhookMux{}, opts, "/?secret=s3cr3t", payload, nil); rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200 for a valid secret, got %d", rec.Code)
+	}
+	if rec := serveWebhook(&WebhookMux{}, opts, "/?secret=wrong", payload, nil); rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status 401 for an invalid secret, got %d", rec.Code)
+	}
+}
+
+func TestWebhookMux_BadRequests(t *testing.T) {
+	if rec := serveWebhook(&WebhookMux{}, WebhookOptions{}, "/", `not json`, nil); rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for an invalid payload, got %d", rec.Code)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	(&WebhookMux{}).Serve(rec, req, WebhookOptions{Parse: parseTestWebhookEvent})
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected status 405 for GET, got %d", rec.Code)
+	}
+}
+
+func TestVerifyWebhookSignature_SHA1(t *testing.T) {
+	// echo -n '{}' | openssl dgst -sha1 -hmac secret
+	if err := VerifyWebhookSignature("secret", []byte("{}"), "sha1=5d61605c3feea9799210ddcb71307d4ba264225f"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
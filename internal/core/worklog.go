@@ -0,0 +1,197 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// maxWorklogIDs is the maximum number of worklog IDs of a GetWorklogsByIDs request.
+const maxWorklogIDs = 1000
+
+// EntityProperty is the property of an entity, e.g. of a worklog.
+type EntityProperty struct {
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
+}
+
+// WorklogChange is a worklog that was updated or deleted.
+type WorklogChange struct {
+	WorklogID int `json:"worklogId" structs:"worklogId"`
+	// UpdatedTime in milliseconds since the Unix epoch.
+	UpdatedTime int64            `json:"updatedTime" structs:"updatedTime"`
+	Properties  []EntityProperty `json:"properties,omitempty" structs:"properties,omitempty"`
+}
+
+// WorklogChanges are the worklogs updated or deleted in a period of time.
+type WorklogChanges struct {
+	Values []WorklogChange `json:"values" structs:"values"`
+	// Since and Until in milliseconds since the Unix epoch.
+	// Until is the since value of the next page, or of the next call once LastPage is reached.
+	Since    int64  `json:"since" structs:"since"`
+	Until    int64  `json:"until" structs:"until"`
+	Self     string `json:"self,omitempty" structs:"self,omitempty"`
+	NextPage string `json:"nextPage,omitempty" structs:"nextPage,omitempty"`
+	LastPage bool   `json:"lastPage" structs:"lastPage"`
+}
+
+// Page sets the cursor of the next page as NextPageToken.
+// The cursor is the "since" parameter of the next page URL.
+func (c *WorklogChanges) Page() Page {
+	page := Page{IsLast: c.LastPage}
+	if u, err := url.Parse(c.NextPage); err == nil {
+		page.NextPageToken = u.Query().Get("since")
+	}
+	return page
+}
+
+// WorklogListOptions specifies the optional parameters of GetUpdatedWorklogs and GetWorklogsByIDs.
+type WorklogListOptions struct {
+	// Expand "properties" to include the worklog properties.
+	Expand string `url:"expand,omitempty"`
+}
+
+// GetUpdatedWorklogs returns the IDs of the worklogs updated since the given time,
+// following the cursor until the last page.
+func GetUpdatedWorklogs(ctx context.Context, c Requester, since time.Time, options *WorklogListOptions) (*WorklogChanges, *Response, error) {
+	if options == nil {
+		options = &WorklogListOptions{}
+	}
+	return getWorklogChanges(ctx, c, "rest/api/2/worklog/updated", since, options)
+}
+
+// GetDeletedWorklogs returns the IDs of the worklogs deleted since the given time,
+// following the cursor until the last page.
+func GetDeletedWorklogs(ctx context.Context, c Requester, since time.Time) (*WorklogChanges, *Response, error) {
+	return getWorklogChanges(ctx, c, "rest/api/2/worklog/deleted", since, &WorklogListOptions{})
+}
+
+// getWorklogChanges collects the changes of all pages into one WorklogChanges.
+func getWorklogChanges(ctx context.Context, c Requester, path string, since time.Time, options *WorklogListOptions) (*WorklogChanges, *Response, error) {
+	changes := &WorklogChanges{Since: since.UnixMilli(), Until: since.UnixMilli()}
+	cursor := strconv.FormatInt(since.UnixMilli(), 10)
+	for {
+		params := struct {
+			Since string `url:"since"`
+			*WorklogListOptions
+		}{cursor, options}
+		apiEndpoint, err := AddOptions(path, params)
+		if err != nil {
+			return nil, nil, err
+		}
+		req, err := c.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		page := new(WorklogChanges)
+		resp, err := c.Do(req, page)
+		if err != nil {
+			return nil, resp, NewError(resp, err)
+		}
+		changes.Values = append(changes.Values, page.Values...)
+		changes.Self = page.Self
+		if page.Until > changes.Until {
+			changes.Until = page.Until
+		}
+
+		if resp.IsLast || resp.NextPageToken == "" || resp.NextPageToken == cursor {
+			changes.LastPage = true
+			return changes, resp, nil
+		}
+		cursor = resp.NextPageToken
+	}
+}
+
+// GetWorklogsByIDs returns the worklogs with the given IDs, decoded as W.
+// Jira returns at most 1000 worklogs per request, more IDs are fetched in several requests.
+func GetWorklogsByIDs[W any](ctx context.Context, c Requester, worklogIDs []int, options *WorklogListOptions) ([]W, *Response, error) {
+	apiEndpoint, err := AddOptions("rest/api/2/worklog/list", options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var worklogs []W
+	var resp *Response
+	for start := 0; start < len(worklogIDs); start += maxWorklogIDs {
+		end := start + maxWorklogIDs
+		if end > len(worklogIDs) {
+			end = len(worklogIDs)
+		}
+		body := struct {
+			IDs []int `json:"ids"`
+		}{worklogIDs[start:end]}
+		req, err := c.NewRequest(ctx, http.MethodPost, apiEndpoint, body)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var records []W
+		resp, err = c.Do(req, &records)
+		if err != nil {
+			return nil, resp, NewError(resp, err)
+		}
+		worklogs = append(worklogs, records...)
+	}
+	return worklogs, resp, nil
+}
+
+// WorklogSyncHandler receives the changes of SyncWorklogs.
+type WorklogSyncHandler[W any] struct {
+	// Updated is called for every created or updated worklog.
+	Updated func(W) error
+	// Deleted is called with the ID of every deleted worklog.
+	Deleted func(worklogID int) error
+}
+
+// SyncWorklogs streams the worklogs updated and deleted since the given time to h.
+// The updated worklogs are passed first, then the deleted ones.
+// It stops and returns the error if a function of h returns one.
+//
+// SyncWorklogs returns the time to pass as since to the next call.
+func SyncWorklogs[W any](ctx context.Context, c Requester, since time.Time, h WorklogSyncHandler[W]) (time.Time, error) {
+	updated, _, err := GetUpdatedWorklogs(ctx, c, since, nil)
+	if err != nil {
+		return since, fmt.Errorf("getting updated worklogs: %w", err)
+	}
+	deleted, _, err := GetDeletedWorklogs(ctx, c, since)
+	if err != nil {
+		return since, fmt.Errorf("getting deleted worklogs: %w", err)
+	}
+
+	if h.Updated != nil && len(updated.Values) > 0 {
+		ids := make([]int, 0, len(updated.Values))
+		for _, change := range updated.Values {
+			ids = append(ids, change.WorklogID)
+		}
+		worklogs, _, err := GetWorklogsByIDs[W](ctx, c, ids, &WorklogListOptions{Expand: "properties"})
+		if err != nil {
+			return since, fmt.Errorf("getting updated worklogs: %w", err)
+		}
+		for _, w := range worklogs {
+			if err := h.Updated(w); err != nil {
+				return since, err
+			}
+		}
+	}
+	if h.Deleted != nil {
+		for _, change := range deleted.Values {
+			if err := h.Deleted(change.WorklogID); err != nil {
+				return since, err
+			}
+		}
+	}
+
+	// Both feeds are complete up to their Until, continue with the earlier one to miss nothing
+	until := updated.Until
+	if deleted.Until < until {
+		until = deleted.Until
+	}
+	if until < since.UnixMilli() {
+		return since, nil
+	}
+	return time.UnixMilli(until), nil
+}
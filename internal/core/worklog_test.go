@@ -0,0 +1,123 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type testWorklog struct {
+	ID string `json:"id"`
+}
+
+// testWorklogFeed serves two pages of updated worklogs (10000, 10001) and one page of deleted worklogs (10002)
+// since 1438013671562, and the worklogs by ID.
+func testWorklogFeed(t *testing.T) *testRequester {
+	c, mux := newTestRequester(t)
+	mux.HandleFunc("/rest/api/2/worklog/updated", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("since") {
+		case "1438013671562":
+			fmt.Fprint(w, `{"values":[{"worklogId":10000,"updatedTime":1438013671562}],"since":1438013671562,"until":1438013693136,"nextPage":"https://jira.example.com/rest/api/2/worklog/updated?since=1438013693136","lastPage":false}`)
+		case "1438013693136":
+			fmt.Fprint(w, `{"values":[{"worklogId":10001,"updatedTime":1438013693136}],"since":1438013693136,"until":1438013700000,"lastPage":true}`)
+		default:
+			t.Errorf("Unexpected since %s", r.URL.Query().Get("since"))
+		}
+	})
+	mux.HandleFunc("/rest/api/2/worklog/deleted", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/worklog/deleted?since=1438013671562")
+		fmt.Fprint(w, `{"values":[{"worklogId":10002,"updatedTime":1438013680000}],"since":1438013671562,"until":1438013690000,"lastPage":true}`)
+	})
+	mux.HandleFunc("/rest/api/2/worklog/list", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body struct {
+			IDs []int `json:"ids"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Error decoding body: %s", err)
+		}
+		if len(body.IDs) > maxWorklogIDs {
+			t.Errorf("Expected at most %d IDs, got %d", maxWorklogIDs, len(body.IDs))
+		}
+		var records []testWorklog
+		for _, id := range body.IDs {
+			records = append(records, testWorklog{ID: fmt.Sprint(id)})
+		}
+		json.NewEncoder(w).Encode(records)
+	})
+	return c
+}
+
+func TestGetUpdatedWorklogs(t *testing.T) {
+	c := testWorklogFeed(t)
+
+	changes, _, err := GetUpdatedWorklogs(context.Background(), c, time.UnixMilli(1438013671562), nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(changes.Values) != 2 || changes.Values[1].WorklogID != 10001 {
+		t.Errorf("Expected the changes of both pages, got %+v", changes.Values)
+	}
+	if changes.Until != 1438013700000 || !changes.LastPage {
+		t.Errorf("Unexpected until %d / last page %t", changes.Until, changes.LastPage)
+	}
+}
+
+func TestGetDeletedWorklogs(t *testing.T) {
+	c := testWorklogFeed(t)
+
+	changes, _, err := GetDeletedWorklogs(context.Background(), c, time.UnixMilli(1438013671562))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(changes.Values) != 1 || changes.Values[0].WorklogID != 10002 {
+		t.Errorf("Unexpected changes: %+v", changes.Values)
+	}
+}
+
+func TestGetWorklogsByIDs(t *testing.T) {
+	c := testWorklogFeed(t)
+
+	ids := make([]int, 2500)
+	for i := range ids {
+		ids[i] = 10000 + i
+	}
+	worklogs, _, err := GetWorklogsByIDs[testWorklog](context.Background(), c, ids, nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(worklogs) != 2500 || worklogs[2499].ID != "12499" {
+		t.Errorf("Expected 2500 worklogs, got %d", len(worklogs))
+	}
+}
+
+func TestSyncWorklogs(t *testing.T) {
+	c := testWorklogFeed(t)
+
+	var updated []string
+	var deleted []int
+	next, err := SyncWorklogs(context.Background(), c, time.UnixMilli(1438013671562), WorklogSyncHandler[testWorklog]{
+		Updated: func(w testWorklog) error {
+			updated = append(updated, w.ID)
+			return nil
+		},
+		Deleted: func(worklogID int) error {
+			deleted = append(deleted, worklogID)
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if fmt.Sprint(updated) != "[10000 10001]" || fmt.Sprint(deleted) != "[10002]" {
+		t.Errorf("Unexpected changes: updated %v, deleted %v", updated, deleted)
+	}
+	if next.UnixMilli() != 1438013690000 {
+		t.Errorf("Expected to continue at the until of the deleted worklogs, got %d", next.UnixMilli())
+	}
+}
@@ -0,0 +1,250 @@
+// Package jira provides a product independent interface on top of the
+// Jira Cloud (package cloud) and Jira Server / Data Center (package onpremise) clients.
+//
+// Tools that need to talk to both products can be written once against the
+// interfaces of this package and get the product specific client injected via an adapter:
+//
+//	cloudClient, _ := cloud.NewClient("https://your.atlassian.net/", tp.Client())
+//	var c jira.Client = jira.NewCloudClient(cloudClient)
+//
+//	onPremiseClient, _ := onpremise.NewClient("https://jira.example.com/", tp.Client())
+//	var c jira.Client = jira.NewOnPremiseClient(onPremiseClient)
+//
+// The adapters normalize the differences between both products,
+// like users being identified by their accountId (Cloud) or name (Server / Data Center).
+package jira
+
+import (
+	"context"
+	"time"
+)
+
+// Deployment describes the Jira product a Client talks to.
+type Deployment string
+
+const (
+	// DeploymentCloud is Jira Cloud.
+	DeploymentCloud Deployment = "cloud"
+	// DeploymentOnPremise is Jira Server or Jira Data Center.
+	DeploymentOnPremise Deployment = "onpremise"
+)
+
+// Client is the product independent Jira client.
+type Client interface {
+	// Deployment returns the Jira product the client talks to.
+	Deployment() Deployment
+
+	Issue() IssueAPI
+	Project() ProjectAPI
+	Search() SearchAPI
+	User() UserAPI
+	Version() VersionAPI
+	Worklog() WorklogAPI
+}
+
+// IssueAPI handles issues.
+type IssueAPI interface {
+	// Get returns the issue identified by its ID or key.
+	Get(ctx context.Context, issueIDOrKey string) (*Issue, error)
+
+	// Create creates a new issue.
+	// At least Project.Key, Type and Summary need to be set.
+	// The returned issue only contains the ID, Key and Self attributes.
+	Create(ctx context.Context, issue *Issue) (*Issue, error)
+
+	// Update sets the given fields of an issue.
+	// The keys of fields are Jira field IDs like "summary" or "customfield_10010".
+	Update(ctx context.Context, issueIDOrKey string, fields map[string]interface{}) error
+
+	// Assign sets the assignee of an issue.
+	// A nil user unassigns the issue.
+	Assign(ctx context.Context, issueIDOrKey string, user *User) error
+
+	// AddComment adds a comment to an issue.
+	AddComment(ctx context.Context, issueIDOrKey string, body string) (*Comment, error)
+
+	// GetTransitions returns the transitions the current user can perform on an issue.
+	GetTransitions(ctx context.Context, issueIDOrKey string) ([]Transition, error)
+
+	// DoTransition performs a transition on an issue.
+	DoTransition(ctx context.Context, issueIDOrKey string, transitionID string) error
+}
+
+// ProjectAPI handles projects.
+type ProjectAPI interface {
+	// Get returns the project identified by its ID or key.
+	Get(ctx context.Context, projectIDOrKey string) (*Project, error)
+
+	// GetAll returns all projects visible to the current user.
+	GetAll(ctx context.Context) ([]Project, error)
+}
+
+// VersionAPI handles project versions (releases).
+type VersionAPI interface {
+	// Get returns the version with the given ID.
+	Get(ctx context.Context, versionID string) (*Version, error)
+
+	// ListByProject returns all versions of a project.
+	ListByProject(ctx context.Context, projectIDOrKey string) ([]Version, error)
+}
+
+// WorklogAPI handles the worklogs (time spent) of issues.
+type WorklogAPI interface {
+	// ListByIssue returns all worklogs of an issue.
+	ListByIssue(ctx context.Context, issueIDOrKey string) ([]Worklog, error)
+}
+
+// SearchAPI searches for issues with JQL.
+type SearchAPI interface {
+	// Search returns a single page of issues matching jql.
+	// Pass SearchResult.NextPageToken via SearchOptions.PageToken to fetch the next page.
+	Search(ctx context.Context, jql string, options *SearchOptions) (*SearchResult, error)
+
+	// SearchPages calls f for every issue matching jql, fetching all pages.
+	// Paging stops at the first error returned by f.
+	SearchPages(ctx context.Context, jql string, options *SearchOptions, f func(Issue) error) error
+}
+
+// UserAPI handles users.
+type UserAPI interface {
+	// GetCurrentUser returns the user the client is authenticated as.
+	GetCurrentUser(ctx context.Context) (*User, error)
+
+	// Get returns the user with the given ID (see User.ID).
+	Get(ctx context.Context, userID string) (*User, error)
+
+	// Find searches users by name, display name or email address.
+	Find(ctx context.Context, query string) ([]User, error)
+}
+
+// User represents a Jira user.
+type User struct {
+	// ID identifies the user in API calls.
+	// This is the accountId on Jira Cloud and the username on Jira Server / Data Center.
+	ID           string
+	DisplayName  string
+	EmailAddress string
+	Active       bool
+	TimeZone     string
+}
+
+// Project represents a Jira project.
+type Project struct {
+	ID          string
+	Key         string
+	Name        string
+	Description string
+	Lead        *User
+}
+
+// Issue represents a Jira issue.
+type Issue struct {
+	ID   string
+	Key  string
+	Self string
+
+	Project     Project
+	Type        string
+	Summary     string
+	Description string
+	Status      string
+	Priority    string
+	Resolution  string
+	Assignee    *User
+	Reporter    *User
+	Labels      []string
+	Components  []string
+	FixVersions []Version
+	Created     time.Time
+	Updated     time.Time
+
+	// CustomFields contains all fields that are not mapped to an attribute,
+	// keyed by their field ID, like "customfield_10010".
+	CustomFields map[string]interface{}
+}
+
+// Version represents a project version (release).
+type Version struct {
+	ID          string
+	Self        string
+	Name        string
+	Description string
+	Released    bool
+	Archived    bool
+	ReleaseDate string
+}
+
+// Worklog represents the time spent on an issue.
+type Worklog struct {
+	ID        string
+	IssueID   string
+	Author    *User
+	Comment   string
+	Started   time.Time
+	TimeSpent time.Duration
+}
+
+// Comment represents a comment of an issue.
+type Comment struct {
+	ID      string
+	Body    string
+	Author  *User
+	Created string
+}
+
+// Transition represents an issue transition.
+type Transition struct {
+	ID       string
+	Name     string
+	ToStatus string
+}
+
+// SearchOptions specifies the optional parameters of the SearchAPI.
+type SearchOptions struct {
+	// MaxResults is the maximum number of issues per page.
+	// The product default is used if zero.
+	MaxResults int
+
+	// Fields is the list of fields to return for each issue.
+	// All navigable fields are returned if empty.
+	Fields []string
+
+	// PageToken selects the page to fetch.
+	// Leave empty for the first page.
+	PageToken string
+}
+
+// SearchResult is a single page of a search.
+type SearchResult struct {
+	Issues []Issue
+
+	// NextPageToken is the token of the next page.
+	// It is empty if this is the last page.
+	NextPageToken string
+}
+
+// searchPages implements SearchAPI.SearchPages on top of SearchAPI.Search.
+func searchPages(ctx context.Context, s SearchAPI, jql string, options *SearchOptions, f func(Issue) error) error {
+	opts := SearchOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	for {
+		result, err := s.Search(ctx, jql, &opts)
+		if err != nil {
+			return err
+		}
+
+		for _, issue := range result.Issues {
+			if err := f(issue); err != nil {
+				return err
+			}
+		}
+
+		if result.NextPageToken == "" || len(result.Issues) == 0 {
+			return nil
+		}
+		opts.PageToken = result.NextPageToken
+	}
+}
@@ -0,0 +1,42 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+var (
+	// testMux is the HTTP request multiplexer used with the test server.
+	testMux *http.ServeMux
+
+	// testServer is a test HTTP server used to provide mock API responses.
+	testServer *httptest.Server
+)
+
+// setup sets up a test HTTP server.
+// Tests should register handlers on mux which provide mock responses for the API method being tested.
+func setup() {
+	testMux = http.NewServeMux()
+	testServer = httptest.NewServer(testMux)
+}
+
+// teardown closes the test HTTP server.
+func teardown() {
+	testServer.Close()
+}
+
+func testMethod(t *testing.T, r *http.Request, want string) {
+	if got := r.Method; got != want {
+		t.Errorf("Request method: %v, want %v", got, want)
+	}
+}
+
+func testRequestParams(t *testing.T, r *http.Request, want map[string]string) {
+	params := r.URL.Query()
+	for key, val := range want {
+		if got := params.Get(key); val != got {
+			t.Errorf("Request param %s: %s, want %s", key, got, val)
+		}
+	}
+}
@@ -0,0 +1,414 @@
+// Package jiraconfig loads the Jira base URL and credentials from a standard chain of sources
+// and creates ready to use clients from them.
+//
+// Every attribute is taken from the first source that sets it:
+//
+//  1. the explicit values passed to Load
+//  2. the JIRA_* environment variables (see the Env* constants)
+//  3. the ~/.netrc file (username and password of the machine matching the host of the base URL)
+//  4. a named profile of the profile file (TOML or YAML, see Loader.ConfigFile)
+//
+// The deployment and credentials of a source configured for another base URL than the resolved one are ignored,
+// e.g. the token of a profile if JIRA_URL points to another instance.
+//
+// The profile file contains one TOML table or YAML mapping of string values per profile.
+// Only this subset of both formats is supported. Other constructs are rejected with an error:
+// YAML nesting deeper than one level, lists, flow collections and block scalars (| and >),
+// as well as TOML dotted keys and tables, arrays (of tables), inline tables and multi-line strings.
+//
+// Example:
+//
+//	cfg, err := jiraconfig.Load(nil)
+//	client, err := cfg.Client() // jira.Client, works with Jira Cloud and Jira Server / Data Center
+package jiraconfig
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	jira "github.com/andygrunwald/go-jira/v2"
+	"github.com/andygrunwald/go-jira/v2/cloud"
+	"github.com/andygrunwald/go-jira/v2/onpremise"
+)
+
+// Environment variables read by the Loader.
+const (
+	EnvURL        = "JIRA_URL"
+	EnvDeployment = "JIRA_DEPLOYMENT"
+	EnvUsername   = "JIRA_USERNAME"
+	EnvAPIToken   = "JIRA_API_TOKEN"
+	EnvPassword   = "JIRA_PASSWORD"
+	EnvToken      = "JIRA_TOKEN"
+	EnvProfile    = "JIRA_PROFILE"
+	EnvConfig     = "JIRA_CONFIG"
+	EnvNetrc      = "NETRC"
+)
+
+// DefaultProfile is the profile used if no profile is configured.
+const DefaultProfile = "default"
+
+// ErrNoBaseURL is returned if none of the sources contains the base URL.
+var ErrNoBaseURL = errors.New("jiraconfig: no Jira base URL configured")
+
+// Config is the configuration of a Jira client.
+type Config struct {
+	// Deployment is the Jira product.
+	// It will be detected from the BaseURL if empty: *.atlassian.net is Jira Cloud.
+	Deployment jira.Deployment
+
+	// BaseURL of the Jira instance, e.g. "https://your.atlassian.net/".
+	BaseURL string
+
+	// Username is the email address (Jira Cloud) or username (Jira Server / Data Center).
+	Username string
+
+	// APIToken is the API token of the user (Jira Cloud).
+	APIToken string
+
+	// Password of the user (Jira Server / Data Center).
+	Password string
+
+	// Token is a Personal Access Token (Jira Server / Data Center).
+	// It takes precedence over Username and Password.
+	Token string
+}
+
+// Load loads the configuration with the default Loader.
+// explicit may be nil.
+func Load(explicit *Config) (*Config, error) {
+	return (&Loader{}).Load(explicit)
+}
+
+// Loader loads a Config from the chain of sources.
+type Loader struct {
+	// Profile is the name of the profile of the profile file.
+	// It will default to $JIRA_PROFILE or DefaultProfile if empty.
+	Profile string
+
+	// ConfigFile is the path of the profile file.
+	// It will default to $JIRA_CONFIG or the first existing file of
+	// ~/.config/jira/config.toml, ~/.config/jira/config.yaml and ~/.config/jira/config.yml if empty.
+	// The format is detected by the file extension (.toml, .yaml or .yml).
+	ConfigFile string
+
+	// NetrcFile is the path of the netrc file.
+	// It will default to $NETRC or ~/.netrc if empty.
+	NetrcFile string
+
+	// Getenv looks up environment variables.
+	// It will default to os.Getenv if nil.
+	Getenv func(key string) string
+}
+
+// Load returns the configuration resolved from explicit (which may be nil),
+// the environment, the netrc file and the profile file.
+func (l *Loader) Load(explicit *Config) (*Config, error) {
+	cfg := &Config{}
+	if explicit != nil {
+		*cfg = *explicit
+	}
+
+	fromEnv := Config{
+		Deployment: jira.Deployment(l.getenv(EnvDeployment)),
+		BaseURL:    l.getenv(EnvURL),
+		Username:   l.getenv(EnvUsername),
+		APIToken:   l.getenv(EnvAPIToken),
+		Password:   l.getenv(EnvPassword),
+		Token:      l.getenv(EnvToken),
+	}
+	cfg.merge(&fromEnv)
+
+	fromProfile, err := l.loadProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	// The base URL is needed to find the matching netrc entry
+	if cfg.BaseURL == "" && fromProfile != nil {
+		cfg.BaseURL = fromProfile.BaseURL
+	}
+	if cfg.BaseURL == "" {
+		return nil, ErrNoBaseURL
+	}
+
+	if !cfg.hasCredentials() {
+		fromNetrc, err := l.loadNetrc(cfg.BaseURL)
+		if err != nil {
+			return nil, err
+		}
+		if fromNetrc != nil {
+			cfg.merge(fromNetrc)
+		}
+	}
+
+	if fromProfile != nil {
+		cfg.merge(fromProfile)
+	}
+
+	if cfg.Deployment == "" {
+		cfg.Deployment = detectDeployment(cfg.BaseURL)
+	}
+	switch cfg.Deployment {
+	case jira.DeploymentCloud, jira.DeploymentOnPremise:
+	default:
+		return nil, fmt.Errorf("jiraconfig: unknown deployment %q, expected %q or %q", cfg.Deployment, jira.DeploymentCloud, jira.DeploymentOnPremise)
+	}
+
+	return cfg, nil
+}
+
+// HTTPClient returns an *http.Client authenticating with the transport matching
+// the Deployment and the configured credentials:
+//
+//   - Jira Cloud: cloud.BasicAuthTransport with Username and APIToken
+//   - Jira Server / Data Center: onpremise.PATAuthTransport with Token or
+//     onpremise.BasicAuthTransport with Username and Password (or APIToken)
+func (c *Config) HTTPClient() (*http.Client, error) {
+	switch c.deployment() {
+	case jira.DeploymentCloud:
+		if c.Username == "" || c.APIToken == "" {
+			return nil, errors.New("jiraconfig: Jira Cloud requires a username and an API token")
+		}
+		tp := &cloud.BasicAuthTransport{Username: c.Username, APIToken: c.APIToken}
+		return tp.Client(), nil
+
+	case jira.DeploymentOnPremise:
+		if c.Token != "" {
+			tp := &onpremise.PATAuthTransport{Token: c.Token}
+			return tp.Client(), nil
+		}
+		password := c.Password
+		if password == "" {
+			password = c.APIToken
+		}
+		if c.Username == "" || password == "" {
+			return nil, errors.New("jiraconfig: Jira Server / Data Center requires a personal access token or a username and password")
+		}
+		tp := &onpremise.BasicAuthTransport{Username: c.Username, Password: password}
+		return tp.Client(), nil
+	}
+	return nil, fmt.Errorf("jiraconfig: unknown deployment %q", c.Deployment)
+}
+
+// CloudClient returns a Jira Cloud client.
+func (c *Config) CloudClient() (*cloud.Client, error) {
+	if d := c.deployment(); d != jira.DeploymentCloud {
+		return nil, fmt.Errorf("jiraconfig: %s is configured as deployment %q", c.BaseURL, d)
+	}
+	httpClient, err := c.HTTPClient()
+	if err != nil {
+		return nil, err
+	}
+	return cloud.NewClient(c.BaseURL, httpClient)
+}
+
+// OnPremiseClient returns a Jira Server / Data Center client.
+func (c *Config) OnPremiseClient() (*onpremise.Client, error) {
+	if d := c.deployment(); d != jira.DeploymentOnPremise {
+		return nil, fmt.Errorf("jiraconfig: %s is configured as deployment %q", c.BaseURL, d)
+	}
+	httpClient, err := c.HTTPClient()
+	if err != nil {
+		return nil, err
+	}
+	return onpremise.NewClient(c.BaseURL, httpClient)
+}
+
+// Client returns a product independent client for the configured Deployment.
+func (c *Config) Client() (jira.Client, error) {
+	if c.deployment() == jira.DeploymentCloud {
+		client, err := c.CloudClient()
+		if err != nil {
+			return nil, err
+		}
+		return jira.NewCloudClient(client), nil
+	}
+
+	client, err := c.OnPremiseClient()
+	if err != nil {
+		return nil, err
+	}
+	return jira.NewOnPremiseClient(client), nil
+}
+
+func (c *Config) deployment() jira.Deployment {
+	if c.Deployment != "" {
+		return c.Deployment
+	}
+	return detectDeployment(c.BaseURL)
+}
+
+func (c *Config) hasCredentials() bool {
+	return c.Token != "" || (c.Username != "" && (c.APIToken != "" || c.Password != ""))
+}
+
+// merge sets all empty attributes of c to the value of o.
+// If o is configured for another Jira instance than c, its deployment and credentials are ignored,
+// so that credentials are never sent to another host than they are configured for.
+func (c *Config) merge(o *Config) {
+	if c.BaseURL == "" {
+		c.BaseURL = o.BaseURL
+	}
+	if o.BaseURL != "" && !sameInstance(c.BaseURL, o.BaseURL) {
+		return
+	}
+	if c.Deployment == "" {
+		c.Deployment = o.Deployment
+	}
+	if c.Username == "" {
+		c.Username = o.Username
+	}
+	if c.APIToken == "" {
+		c.APIToken = o.APIToken
+	}
+	if c.Password == "" {
+		c.Password = o.Password
+	}
+	if c.Token == "" {
+		c.Token = o.Token
+	}
+}
+
+// sameInstance reports if the base URLs a and b point to the same Jira instance.
+// Scheme and host are compared case insensitive, a trailing slash of the path is ignored.
+func sameInstance(a, b string) bool {
+	ua, err := url.Parse(a)
+	if err != nil {
+		return false
+	}
+	ub, err := url.Parse(b)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(ua.Scheme, ub.Scheme) && strings.EqualFold(ua.Host, ub.Host) &&
+		strings.TrimSuffix(ua.Path, "/") == strings.TrimSuffix(ub.Path, "/")
+}
+
+// detectDeployment returns DeploymentCloud for Atlassian hosted sites
+// and DeploymentOnPremise otherwise.
+func detectDeployment(baseURL string) jira.Deployment {
+	u, err := url.Parse(baseURL)
+	if err == nil {
+		host := strings.ToLower(u.Hostname())
+		if strings.HasSuffix(host, ".atlassian.net") || strings.HasSuffix(host, ".jira.com") {
+			return jira.DeploymentCloud
+		}
+	}
+	return jira.DeploymentOnPremise
+}
+
+func (l *Loader) getenv(key string) string {
+	if l.Getenv != nil {
+		return l.Getenv(key)
+	}
+	return os.Getenv(key)
+}
+
+// loadProfile returns the configured profile of the profile file,
+// or nil if there is no profile file.
+func (l *Loader) loadProfile() (*Config, error) {
+	path := l.ConfigFile
+	if path == "" {
+		path = l.getenv(EnvConfig)
+	}
+	explicit := path != ""
+	if !explicit {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, nil
+		}
+		for _, name := range []string{"config.toml", "config.yaml", "config.yml"} {
+			candidate := filepath.Join(home, ".config", "jira", name)
+			if _, err := os.Stat(candidate); err == nil {
+				path = candidate
+				break
+			}
+		}
+		if path == "" {
+			return nil, nil
+		}
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("jiraconfig: reading profile file: %w", err)
+	}
+
+	var profiles map[string]map[string]string
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".toml":
+		profiles, err = parseTOML(b)
+	case ".yaml", ".yml":
+		profiles, err = parseYAML(b)
+	default:
+		return nil, fmt.Errorf("jiraconfig: unknown format of profile file %s, expected .toml, .yaml or .yml", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("jiraconfig: parsing %s: %w", path, err)
+	}
+
+	name := l.Profile
+	if name == "" {
+		name = l.getenv(EnvProfile)
+	}
+	if name == "" {
+		name = DefaultProfile
+	}
+	profile, ok := profiles[name]
+	if !ok {
+		// Only a profile that was asked for explicitly has to exist
+		if l.Profile != "" || l.getenv(EnvProfile) != "" {
+			return nil, fmt.Errorf("jiraconfig: profile %q not found in %s", name, path)
+		}
+		return nil, nil
+	}
+
+	return &Config{
+		Deployment: jira.Deployment(profile["deployment"]),
+		BaseURL:    profile["url"],
+		Username:   profile["username"],
+		APIToken:   profile["api_token"],
+		Password:   profile["password"],
+		Token:      profile["token"],
+	}, nil
+}
+
+// loadNetrc returns the credentials of the netrc entry matching the host of baseURL,
+// or nil if there is none.
+func (l *Loader) loadNetrc(baseURL string) (*Config, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("jiraconfig: parsing base URL: %w", err)
+	}
+
+	path := l.NetrcFile
+	if path == "" {
+		path = l.getenv(EnvNetrc)
+	}
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, nil
+		}
+		path = filepath.Join(home, ".netrc")
+	}
+
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("jiraconfig: reading netrc file: %w", err)
+	}
+
+	machine := findNetrcMachine(parseNetrc(string(b)), u.Hostname())
+	if machine == nil {
+		return nil, nil
+	}
+	return &Config{Username: machine.login, Password: machine.password, APIToken: machine.password}, nil
+}
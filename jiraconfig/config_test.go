@@ -0,0 +1,219 @@
+package jiraconfig
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira/v2"
+)
+
+// testLoader returns a Loader that only reads the given environment and files.
+// files may contain a "netrc" file and a profile file (e.g. "config.toml").
+func testLoader(t *testing.T, env map[string]string, files map[string]string) *Loader {
+	t.Helper()
+	dir := t.TempDir()
+	l := &Loader{
+		NetrcFile:  filepath.Join(dir, "netrc"),
+		ConfigFile: filepath.Join(dir, "empty.toml"),
+		Getenv:     func(key string) string { return env[key] },
+	}
+	files["empty.toml"] = ""
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if name != "netrc" && name != "empty.toml" {
+			l.ConfigFile = path
+		}
+	}
+	return l
+}
+
+func TestLoader_Precedence(t *testing.T) {
+	env := map[string]string{
+		EnvURL:      "https://env.atlassian.net/",
+		EnvUsername: "env-user",
+	}
+	files := map[string]string{
+		"netrc": "machine env.atlassian.net login netrc-user password netrc-token\n",
+		"config.toml": `
+[default]
+url = "https://profile.atlassian.net/"
+username = "profile-user"
+api_token = "profile-token"
+`,
+	}
+
+	cfg, err := testLoader(t, env, files).Load(&Config{Username: "explicit-user"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	want := Config{
+		Deployment: jira.DeploymentCloud,
+		BaseURL:    "https://env.atlassian.net/",
+		Username:   "explicit-user",
+		APIToken:   "netrc-token",
+		Password:   "netrc-token",
+	}
+	if *cfg != want {
+		t.Errorf("Load returned %+v, want %+v", *cfg, want)
+	}
+}
+
+func TestLoader_ProfileOfAnotherInstance(t *testing.T) {
+	files := map[string]string{
+		"config.toml": `
+[default]
+url = "https://profile.atlassian.net/"
+username = "profile-user"
+api_token = "profile-token"
+`,
+	}
+
+	cfg, err := testLoader(t, map[string]string{EnvURL: "https://env.example.com/"}, files).Load(nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if cfg.BaseURL != "https://env.example.com/" || cfg.Username != "" || cfg.APIToken != "" {
+		t.Errorf("Expected the credentials of the profile to be ignored, got %+v", cfg)
+	}
+
+	cfg, err = testLoader(t, map[string]string{EnvURL: "https://PROFILE.atlassian.net"}, files).Load(nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if cfg.Username != "profile-user" || cfg.APIToken != "profile-token" {
+		t.Errorf("Expected the credentials of the profile for the same instance, got %+v", cfg)
+	}
+}
+
+func TestLoader_Profile(t *testing.T) {
+	files := map[string]string{
+		"config.yaml": `
+default:
+  url: https://your.atlassian.net/
+dc:
+  url: https://jira.example.com/
+  token: "pat"
+`,
+	}
+
+	cfg, err := testLoader(t, map[string]string{EnvProfile: "dc"}, files).Load(nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if cfg.BaseURL != "https://jira.example.com/" || cfg.Token != "pat" || cfg.Deployment != jira.DeploymentOnPremise {
+		t.Errorf("Unexpected config: %+v", cfg)
+	}
+
+	l := testLoader(t, nil, files)
+	l.Profile = "unknown"
+	if _, err := l.Load(nil); err == nil {
+		t.Error("Expected an error for an unknown profile")
+	}
+}
+
+func TestLoader_NoBaseURL(t *testing.T) {
+	if _, err := testLoader(t, nil, map[string]string{}).Load(nil); err != ErrNoBaseURL {
+		t.Errorf("Expected ErrNoBaseURL, got %v", err)
+	}
+}
+
+func TestLoader_UnknownDeployment(t *testing.T) {
+	env := map[string]string{EnvURL: "https://jira.example.com/", EnvDeployment: "server"}
+	if _, err := testLoader(t, env, map[string]string{}).Load(nil); err == nil {
+		t.Error("Expected an error for an unknown deployment")
+	}
+}
+
+func TestDetectDeployment(t *testing.T) {
+	tests := map[string]jira.Deployment{
+		"https://your.atlassian.net/":    jira.DeploymentCloud,
+		"https://YOUR.Atlassian.net":     jira.DeploymentCloud,
+		"https://jira.example.com/jira/": jira.DeploymentOnPremise,
+		"http://localhost:8080":          jira.DeploymentOnPremise,
+	}
+	for baseURL, want := range tests {
+		if got := detectDeployment(baseURL); got != want {
+			t.Errorf("detectDeployment(%q) = %q, want %q", baseURL, got, want)
+		}
+	}
+}
+
+func TestConfig_HTTPClient(t *testing.T) {
+	tests := []struct {
+		name       string
+		cfg        Config
+		wantHeader string
+		wantErr    bool
+	}{
+		{"cloud", Config{Deployment: jira.DeploymentCloud, Username: "u", APIToken: "t"}, "Basic dTp0", false},
+		{"cloud without token", Config{Deployment: jira.DeploymentCloud, Username: "u"}, "", true},
+		{"onpremise PAT", Config{Deployment: jira.DeploymentOnPremise, Username: "u", Password: "p", Token: "pat"}, "Bearer pat", false},
+		{"onpremise basic", Config{Deployment: jira.DeploymentOnPremise, Username: "u", Password: "p"}, "Basic dTpw", false},
+		{"onpremise without credentials", Config{Deployment: jira.DeploymentOnPremise}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r.Header.Get("Authorization")
+			}))
+			defer server.Close()
+
+			client, err := tt.cfg.HTTPClient()
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Error given: %s", err)
+			}
+			client.Get(server.URL)
+			if got != tt.wantHeader {
+				t.Errorf("Authorization header is %q, want %q", got, tt.wantHeader)
+			}
+		})
+	}
+}
+
+func TestConfig_Client(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/myself" {
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"name":"jdoe","displayName":"John Doe"}`)
+	}))
+	defer server.Close()
+
+	cfg := &Config{BaseURL: server.URL, Token: "pat"}
+	client, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if client.Deployment() != jira.DeploymentOnPremise {
+		t.Errorf("Expected an on-premise client, got %s", client.Deployment())
+	}
+
+	user, err := client.User().GetCurrentUser(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if user.ID != "jdoe" {
+		t.Errorf("Expected user jdoe, got %+v", user)
+	}
+
+	if _, err := cfg.CloudClient(); err == nil {
+		t.Error("Expected an error for a cloud client of an on-premise config")
+	}
+}
@@ -0,0 +1,71 @@
+package jiraconfig
+
+import "strings"
+
+// netrcMachine is an entry of a netrc file.
+type netrcMachine struct {
+	name     string
+	login    string
+	password string
+}
+
+// parseNetrc parses the content of a netrc file.
+// The "default" entry is returned with an empty name.
+// Macro definitions (macdef) are skipped.
+//
+// Format: https://www.gnu.org/software/inetutils/manual/html_node/The-_002enetrc-file.html
+func parseNetrc(data string) []netrcMachine {
+	var machines []netrcMachine
+	var current *netrcMachine
+
+	lines := strings.Split(data, "\n")
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		if j := strings.Index(line, "#"); j >= 0 {
+			line = line[:j]
+		}
+		fields := strings.Fields(line)
+		for j := 0; j < len(fields); j++ {
+			switch fields[j] {
+			case "machine", "default":
+				machines = append(machines, netrcMachine{})
+				current = &machines[len(machines)-1]
+				if fields[j] == "machine" && j+1 < len(fields) {
+					j++
+					current.name = fields[j]
+				}
+			case "login", "password", "account":
+				if current == nil || j+1 >= len(fields) {
+					continue
+				}
+				j++
+				if fields[j-1] == "login" {
+					current.login = fields[j]
+				} else if fields[j-1] == "password" {
+					current.password = fields[j]
+				}
+			case "macdef":
+				// A macro definition ends with an empty line
+				for i+1 < len(lines) && strings.TrimSpace(lines[i+1]) != "" {
+					i++
+				}
+				j = len(fields)
+			}
+		}
+	}
+	return machines
+}
+
+// findNetrcMachine returns the entry for host, falling back to the default entry.
+func findNetrcMachine(machines []netrcMachine, host string) *netrcMachine {
+	var fallback *netrcMachine
+	for i := range machines {
+		if strings.EqualFold(machines[i].name, host) {
+			return &machines[i]
+		}
+		if machines[i].name == "" && fallback == nil {
+			fallback = &machines[i]
+		}
+	}
+	return fallback
+}
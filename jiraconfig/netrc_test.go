@@ -0,0 +1,38 @@
+package jiraconfig
+
+import "testing"
+
+func TestParseNetrc(t *testing.T) {
+	data := `# comment
+machine jira.example.com
+	login jdoe
+	password secret # trailing comment
+
+macdef init
+cd /pub
+bin
+
+machine other.example.com login other password other-secret account acme
+default login anonymous password guest
+`
+
+	machines := parseNetrc(data)
+	if len(machines) != 3 {
+		t.Fatalf("Expected 3 entries, got %d: %+v", len(machines), machines)
+	}
+
+	m := findNetrcMachine(machines, "JIRA.example.com")
+	if m == nil || m.login != "jdoe" || m.password != "secret" {
+		t.Errorf("Unexpected entry for jira.example.com: %+v", m)
+	}
+
+	m = findNetrcMachine(machines, "other.example.com")
+	if m == nil || m.login != "other" || m.password != "other-secret" {
+		t.Errorf("Unexpected entry for other.example.com: %+v", m)
+	}
+
+	m = findNetrcMachine(machines, "unknown.example.com")
+	if m == nil || m.login != "anonymous" {
+		t.Errorf("Expected the default entry, got %+v", m)
+	}
+}
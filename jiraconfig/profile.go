@@ -0,0 +1,217 @@
+package jiraconfig
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The profile file contains one table (TOML) or mapping (YAML) per profile.
+// Only string values are supported, which is all a profile needs:
+//
+//	# config.toml
+//	[default]
+//	url = "https://your.atlassian.net/"
+//	username = "you@example.com"
+//	api_token = "..."
+//
+//	[datacenter]
+//	url = "https://jira.example.com/"
+//	deployment = "onpremise"
+//	token = "..."
+//
+//	# config.yaml
+//	default:
+//	  url: https://your.atlassian.net/
+//	  username: you@example.com
+//	  api_token: "..."
+
+// parseTOML parses the subset of TOML used by profile files:
+// Tables with string, number or boolean values, and comments.
+// Other constructs, like dotted keys and tables, arrays, inline tables and multi-line strings,
+// are rejected instead of being misread.
+func parseTOML(data []byte) (map[string]map[string]string, error) {
+	profiles := make(map[string]map[string]string)
+	var current map[string]string
+
+	for n, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			end := strings.Index(line, "]")
+			if end < 0 || strings.HasPrefix(line, "[[") {
+				return nil, fmt.Errorf("line %d: invalid table header %q", n+1, line)
+			}
+			rawName := strings.TrimSpace(line[1:end])
+			if rest := strings.TrimSpace(line[end+1:]); rest != "" && !strings.HasPrefix(rest, "#") {
+				return nil, fmt.Errorf("line %d: invalid table header %q", n+1, line)
+			}
+			if isDotted(rawName) {
+				return nil, fmt.Errorf("line %d: nested tables are not supported", n+1)
+			}
+			name := unquoteKey(rawName)
+			if profiles[name] == nil {
+				profiles[name] = make(map[string]string)
+			}
+			current = profiles[name]
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", n+1)
+		}
+		if current == nil {
+			return nil, fmt.Errorf("line %d: key outside of a profile table", n+1)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		switch {
+		case isDotted(key):
+			return nil, fmt.Errorf("line %d: dotted keys are not supported", n+1)
+		case strings.HasPrefix(value, `"""`) || strings.HasPrefix(value, "'''"):
+			return nil, fmt.Errorf("line %d: multi-line strings are not supported", n+1)
+		case strings.HasPrefix(value, "[") || strings.HasPrefix(value, "{"):
+			return nil, fmt.Errorf("line %d: arrays and inline tables are not supported", n+1)
+		}
+		v, err := parseValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n+1, err)
+		}
+		current[unquoteKey(key)] = v
+	}
+	return profiles, nil
+}
+
+// parseYAML parses the subset of YAML used by profile files:
+// A mapping of profile names to mappings of scalar values, and comments.
+// Other constructs, like deeper nesting, lists, flow collections and block scalars,
+// are rejected instead of being misread.
+func parseYAML(data []byte) (map[string]map[string]string, error) {
+	profiles := make(map[string]map[string]string)
+	var current map[string]string
+	indent := ""
+
+	for n, line := range strings.Split(string(data), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || trimmed == "---" {
+			continue
+		}
+		if trimmed == "-" || strings.HasPrefix(trimmed, "- ") {
+			return nil, fmt.Errorf("line %d: lists are not supported", n+1)
+		}
+
+		key, value, ok := strings.Cut(trimmed, ":")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key: value", n+1)
+		}
+		key = unquoteKey(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		if line[0] != ' ' && line[0] != '\t' {
+			if value != "" {
+				return nil, fmt.Errorf("line %d: expected profile %q to be a mapping", n+1, key)
+			}
+			if profiles[key] == nil {
+				profiles[key] = make(map[string]string)
+			}
+			current = profiles[key]
+			indent = ""
+			continue
+		}
+
+		if current == nil {
+			return nil, fmt.Errorf("line %d: key outside of a profile", n+1)
+		}
+		lineIndent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if indent == "" {
+			indent = lineIndent
+		}
+		if lineIndent != indent {
+			return nil, fmt.Errorf("line %d: nested mappings are not supported", n+1)
+		}
+		switch {
+		case value == "":
+			return nil, fmt.Errorf("line %d: expected a value for %q, nested mappings are not supported", n+1, key)
+		case strings.HasPrefix(value, "|") || strings.HasPrefix(value, ">"):
+			return nil, fmt.Errorf("line %d: block scalars are not supported", n+1)
+		case strings.HasPrefix(value, "[") || strings.HasPrefix(value, "{"):
+			return nil, fmt.Errorf("line %d: flow collections are not supported", n+1)
+		}
+		v, err := parseValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n+1, err)
+		}
+		current[key] = v
+	}
+	return profiles, nil
+}
+
+// parseValue parses a double quoted, single quoted or bare value
+// and strips trailing comments.
+func parseValue(s string) (string, error) {
+	switch {
+	case strings.HasPrefix(s, `"`):
+		end := closingQuote(s)
+		if end < 0 {
+			return "", fmt.Errorf("unterminated string %s", s)
+		}
+		if err := checkTrailing(s[end+1:]); err != nil {
+			return "", err
+		}
+		return strconv.Unquote(s[:end+1])
+	case strings.HasPrefix(s, "'"):
+		end := strings.Index(s[1:], "'")
+		if end < 0 {
+			return "", fmt.Errorf("unterminated string %s", s)
+		}
+		if err := checkTrailing(s[end+2:]); err != nil {
+			return "", err
+		}
+		return s[1 : end+1], nil
+	}
+	if i := strings.Index(s, " #"); i >= 0 {
+		s = s[:i]
+	}
+	return strings.TrimSpace(s), nil
+}
+
+// checkTrailing returns an error if rest, the text following a quoted value, is not empty or a comment.
+func checkTrailing(rest string) error {
+	rest = strings.TrimSpace(rest)
+	if rest != "" && !strings.HasPrefix(rest, "#") {
+		return fmt.Errorf("unexpected %q after string", rest)
+	}
+	return nil
+}
+
+// isDotted reports if the unquoted key s consists of several dotted keys, like "a.b".
+func isDotted(s string) bool {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return false
+	}
+	return strings.Contains(s, ".")
+}
+
+// closingQuote returns the index of the closing double quote of s.
+func closingQuote(s string) int {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+	return -1
+}
+
+func unquoteKey(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
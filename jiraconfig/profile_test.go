@@ -0,0 +1,110 @@
+package jiraconfig
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTOML(t *testing.T) {
+	data := []byte(`
+# profiles
+[default]
+url = "https://your.atlassian.net/" # cloud
+username = 'you@example.com'
+api_token = "tok\"en"
+
+["data.center"]
+url = https://jira.example.com/
+deployment = "onpremise"
+`)
+
+	got, err := parseTOML(data)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := map[string]map[string]string{
+		"default": {
+			"url":       "https://your.atlassian.net/",
+			"username":  "you@example.com",
+			"api_token": `tok"en`,
+		},
+		"data.center": {
+			"url":        "https://jira.example.com/",
+			"deployment": "onpremise",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseTOML returned %v, want %v", got, want)
+	}
+}
+
+func TestParseTOML_Invalid(t *testing.T) {
+	for _, data := range []string{
+		"url = \"outside\"",
+		"[default\nurl = \"x\"",
+		"[default]\nurl",
+		"[default]\nurl = \"unterminated",
+		"[default]\napi_token = \"\"\"\nsecret\n\"\"\"",
+		"[default]\napi_token = '''secret'''",
+		"[default]\nauth = { token = \"x\" }",
+		"[default]\nscopes = [\"a\"]",
+		"[default.auth]\ntoken = \"x\"",
+		"[default]\nauth.token = \"x\"",
+		"[default]\nurl = \"x\" \"y\"",
+	} {
+		if _, err := parseTOML([]byte(data)); err == nil {
+			t.Errorf("Expected an error for %q", data)
+		}
+	}
+}
+
+func TestParseYAML(t *testing.T) {
+	data := []byte(`---
+# profiles
+default:
+  url: https://your.atlassian.net/
+  username: "you@example.com"
+  api_token: 'token' # comment
+dc:
+  url: https://jira.example.com/
+  token: pat
+`)
+
+	got, err := parseYAML(data)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := map[string]map[string]string{
+		"default": {
+			"url":       "https://your.atlassian.net/",
+			"username":  "you@example.com",
+			"api_token": "token",
+		},
+		"dc": {
+			"url":   "https://jira.example.com/",
+			"token": "pat",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseYAML returned %v, want %v", got, want)
+	}
+}
+
+func TestParseYAML_Invalid(t *testing.T) {
+	for _, data := range []string{
+		"  url: outside",
+		"default: value",
+		"default:\n  url",
+		"default:\n  url: x\n  - item",
+		"- default:\n  url: x",
+		"default:\n  auth:\n    token: x",
+		"default:\n  url: x\n    token: y",
+		"default:\n  token: |\n    x",
+		"default:\n  token: >-\n    x",
+		"default:\n  scopes: [a, b]",
+	} {
+		if _, err := parseYAML([]byte(data)); err == nil {
+			t.Errorf("Expected an error for %q", data)
+		}
+	}
+}
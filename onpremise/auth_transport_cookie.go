@@ -2,9 +2,13 @@ package onpremise
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -14,6 +18,11 @@ import (
 // Note that it is generally preferable to use HTTP BASIC authentication with the REST API.
 // However, this resource may be used to mimic the behaviour of Jira's log-in page (e.g. to display log-in errors to a user).
 //
+// If the session expired (Jira answers with 401 Unauthorized or an X-Seraph-LoginReason header
+// of AUTHENTICATION_DENIED or OUT), the transport logs in again and replays the request once.
+// When many goroutines hit the expired session at the same time, only one of them logs in.
+// If Jira requires a CAPTCHA to be solved, a *CaptchaChallengeError is returned.
+//
 // Jira API docs: https://docs.atlassian.com/jira/REST/latest/#auth/1/session
 type CookieAuthTransport struct {
 	Username string
@@ -27,38 +36,125 @@ type CookieAuthTransport struct {
 	// Transport is the underlying HTTP transport to use when making requests.
 	// It will default to http.DefaultTransport if nil.
 	Transport http.RoundTripper
+
+	mu sync.Mutex
+	// generation is incremented with every login, so that concurrent requests
+	// that failed with the same expired session only trigger one login.
+	generation int
+}
+
+// CaptchaChallengeError is returned if Jira denies the authentication
+// until the user solved a CAPTCHA, usually after too many failed login attempts.
+// The CAPTCHA needs to be solved in the browser at LoginURL.
+type CaptchaChallengeError struct {
+	LoginURL string
+}
+
+func (e *CaptchaChallengeError) Error() string {
+	if e.LoginURL == "" {
+		return "cookieauth: authentication denied, CAPTCHA challenge required"
+	}
+	return fmt.Sprintf("cookieauth: authentication denied, CAPTCHA challenge required, login at %s", e.LoginURL)
 }
 
 // RoundTrip adds the session object to the request.
 func (t *CookieAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	if t.SessionObject == nil {
-		err := t.setSessionObject()
-		if err != nil {
-			return nil, fmt.Errorf("cookieauth: no session object has been set: %w", err)
-		}
+	session, generation, err := t.session(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("cookieauth: no session object has been set: %w", err)
+	}
+
+	resp, err := t.transport().RoundTrip(t.authenticatedRequest(req, session))
+	if err != nil {
+		return nil, err
+	}
+	if err := captchaChallenge(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	if !sessionExpired(resp) {
+		return resp, nil
 	}
 
+	// The request can only be replayed if its body can be read again
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+	body, err := replayBody(req)
+	if err != nil {
+		return resp, nil
+	}
+	resp.Body.Close()
+
+	session, err = t.renewSession(req.Context(), generation)
+	if err != nil {
+		return nil, fmt.Errorf("cookieauth: renewing the session failed: %w", err)
+	}
+
+	req2 := t.authenticatedRequest(req, session)
+	req2.Body = body
+	resp, err = t.transport().RoundTrip(req2)
+	if err != nil {
+		return nil, err
+	}
+	if err := captchaChallenge(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Client returns an *http.Client that makes requests that are authenticated
+// using cookie authentication
+func (t *CookieAuthTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// authenticatedRequest returns a clone of req carrying the session cookies.
+func (t *CookieAuthTransport) authenticatedRequest(req *http.Request, session []*http.Cookie) *http.Request {
 	req2 := cloneRequest(req) // per RoundTripper contract
-	for _, cookie := range t.SessionObject {
+	for _, cookie := range session {
 		// Don't add an empty value cookie to the request
 		if cookie.Value != "" {
 			req2.AddCookie(cookie)
 		}
 	}
+	return req2
+}
+
+// session returns the current session and its generation.
+// It logs in if there is no session yet.
+func (t *CookieAuthTransport) session(ctx context.Context) ([]*http.Cookie, int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
 
-	return t.transport().RoundTrip(req2)
+	if t.SessionObject == nil {
+		if err := t.setSessionObject(ctx); err != nil {
+			return nil, 0, err
+		}
+	}
+	return t.SessionObject, t.generation, nil
 }
 
-// Client returns an *http.Client that makes requests that are authenticated
-// using cookie authentication
-func (t *CookieAuthTransport) Client() *http.Client {
-	return &http.Client{Transport: t}
+// renewSession logs in again, unless the session was already renewed
+// since generation, e.g. by a concurrent request.
+func (t *CookieAuthTransport) renewSession(ctx context.Context, generation int) ([]*http.Cookie, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.generation == generation {
+		if err := t.setSessionObject(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return t.SessionObject, nil
 }
 
 // setSessionObject attempts to authenticate the user and set
-// the session object (e.g. cookie)
-func (t *CookieAuthTransport) setSessionObject() error {
-	req, err := t.buildAuthRequest()
+// the session object (e.g. cookie).
+// The caller must hold t.mu.
+func (t *CookieAuthTransport) setSessionObject(ctx context.Context) error {
+	req, err := t.buildAuthRequest(ctx)
 	if err != nil {
 		return err
 	}
@@ -72,12 +168,20 @@ func (t *CookieAuthTransport) setSessionObject() error {
 	}
 	defer resp.Body.Close()
 
+	if err := captchaChallenge(resp); err != nil {
+		return err
+	}
+	if err := CheckResponse(resp); err != nil {
+		return err
+	}
+
 	t.SessionObject = resp.Cookies()
+	t.generation++
 	return nil
 }
 
 // getAuthRequest assembles the request to get the authenticated cookie
-func (t *CookieAuthTransport) buildAuthRequest() (*http.Request, error) {
+func (t *CookieAuthTransport) buildAuthRequest(ctx context.Context) (*http.Request, error) {
 	body := struct {
 		Username string `json:"username"`
 		Password string `json:"password"`
@@ -89,8 +193,7 @@ func (t *CookieAuthTransport) buildAuthRequest() (*http.Request, error) {
 	b := new(bytes.Buffer)
 	json.NewEncoder(b).Encode(body)
 
-	// TODO Use a context here
-	req, err := http.NewRequest(http.MethodPost, t.AuthURL, b)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.AuthURL, b)
 	if err != nil {
 		return nil, err
 	}
@@ -105,3 +208,40 @@ func (t *CookieAuthTransport) transport() http.RoundTripper {
 	}
 	return http.DefaultTransport
 }
+
+// sessionExpired reports whether Jira rejected the session of the request.
+func sessionExpired(resp *http.Response) bool {
+	if resp.StatusCode == http.StatusUnauthorized {
+		return true
+	}
+	switch resp.Header.Get("X-Seraph-LoginReason") {
+	case "AUTHENTICATION_DENIED", "OUT":
+		return true
+	}
+	return false
+}
+
+// captchaChallenge returns a *CaptchaChallengeError if Jira requires a CAPTCHA to be solved.
+// The header looks like "CAPTCHA_CHALLENGE; login-url=https://jira.example.com/login.jsp".
+func captchaChallenge(resp *http.Response) error {
+	reason := resp.Header.Get("X-Authentication-Denied-Reason")
+	if !strings.HasPrefix(reason, "CAPTCHA_CHALLENGE") {
+		return nil
+	}
+
+	e := &CaptchaChallengeError{}
+	for _, part := range strings.Split(reason, ";") {
+		if k, v, ok := strings.Cut(strings.TrimSpace(part), "="); ok && k == "login-url" {
+			e.LoginURL = v
+		}
+	}
+	return e
+}
+
+// replayBody returns a fresh copy of the body of req.
+func replayBody(req *http.Request) (io.ReadCloser, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req.Body, nil
+	}
+	return req.GetBody()
+}
@@ -2,8 +2,13 @@ package onpremise
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 )
 
@@ -118,3 +123,194 @@ func TestCookieAuthTransport_SessionObject_DoesNotExist(t *testing.T) {
 	req, _ := basicAuthClient.NewRequest(context.Background(), http.MethodGet, ".", nil)
 	basicAuthClient.Do(req, nil)
 }
+
+// Test that an expired session is renewed and the request replayed
+func TestCookieAuthTransport_SessionExpired(t *testing.T) {
+	setup()
+	defer teardown()
+
+	logins := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logins++
+		http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: fmt.Sprintf("session-%d", logins)})
+	}))
+	defer ts.Close()
+
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		cookie, err := r.Cookie("JSESSIONID")
+		if err != nil || cookie.Value != "session-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), "Replayed") {
+			t.Errorf("Expected the request body to be replayed, got %s", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10000","key":"EX-1"}`)
+	})
+
+	tp := &CookieAuthTransport{Username: "username", Password: "password", AuthURL: ts.URL}
+	c, _ := NewClient(testServer.URL, tp.Client())
+
+	issue, _, err := c.Issue.Create(context.Background(), &Issue{Fields: &IssueFields{Summary: "Replayed"}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Key != "EX-1" {
+		t.Errorf("Expected issue EX-1, got %s", issue.Key)
+	}
+	if logins != 2 {
+		t.Errorf("Expected 2 logins, got %d", logins)
+	}
+}
+
+// Test that an X-Seraph-LoginReason header is treated as an expired session
+func TestCookieAuthTransport_SeraphLoginReason(t *testing.T) {
+	setup()
+	defer teardown()
+
+	logins := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logins++
+		http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: "renewed"})
+	}))
+	defer ts.Close()
+
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		if cookie, err := r.Cookie("JSESSIONID"); err != nil || cookie.Value != "renewed" {
+			w.Header().Set("X-Seraph-LoginReason", "AUTHENTICATION_DENIED")
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		fmt.Fprint(w, `{"name":"username"}`)
+	})
+
+	tp := &CookieAuthTransport{
+		Username:      "username",
+		Password:      "password",
+		AuthURL:       ts.URL,
+		SessionObject: []*http.Cookie{{Name: "JSESSIONID", Value: "expired"}},
+	}
+	c, _ := NewClient(testServer.URL, tp.Client())
+
+	if _, _, err := c.User.GetSelf(context.Background()); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if logins != 1 {
+		t.Errorf("Expected 1 login, got %d", logins)
+	}
+}
+
+// Test that concurrent requests with an expired session only trigger one login
+func TestCookieAuthTransport_ConcurrentRenewal(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	logins := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		logins++
+		mu.Unlock()
+		http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: "renewed"})
+	}))
+	defer ts.Close()
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if cookie, err := r.Cookie("JSESSIONID"); err != nil || cookie.Value != "renewed" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	})
+
+	tp := &CookieAuthTransport{
+		Username:      "username",
+		Password:      "password",
+		AuthURL:       ts.URL,
+		SessionObject: []*http.Cookie{{Name: "JSESSIONID", Value: "expired"}},
+	}
+	c, _ := NewClient(testServer.URL, tp.Client())
+
+	// all requests need to fail with the expired session, before the session is renewed
+	var started sync.WaitGroup
+	started.Add(10)
+	tp.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if cookie, _ := req.Cookie("JSESSIONID"); cookie != nil && cookie.Value == "expired" {
+			started.Done()
+			started.Wait()
+		}
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := c.NewRequest(context.Background(), http.MethodGet, ".", nil)
+			if _, err := c.Do(req, nil); err != nil {
+				t.Errorf("Error given: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if logins != 1 {
+		t.Errorf("Expected 1 login, got %d", logins)
+	}
+}
+
+// Test that a CAPTCHA challenge is returned as a typed error
+func TestCookieAuthTransport_CaptchaChallenge(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Authentication-Denied-Reason", "CAPTCHA_CHALLENGE; login-url=https://jira.example.com/login.jsp")
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer ts.Close()
+
+	tp := &CookieAuthTransport{Username: "username", Password: "password", AuthURL: ts.URL}
+	c, _ := NewClient(testServer.URL, tp.Client())
+
+	req, _ := c.NewRequest(context.Background(), http.MethodGet, ".", nil)
+	_, err := c.Do(req, nil)
+
+	var captchaErr *CaptchaChallengeError
+	if !errors.As(err, &captchaErr) {
+		t.Fatalf("Expected a *CaptchaChallengeError, got %v", err)
+	}
+	if captchaErr.LoginURL != "https://jira.example.com/login.jsp" {
+		t.Errorf("Unexpected login URL %q", captchaErr.LoginURL)
+	}
+}
+
+// Test that a failed login is reported
+func TestCookieAuthTransport_LoginFailed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	tp := &CookieAuthTransport{Username: "username", Password: "wrong", AuthURL: ts.URL}
+	c, _ := NewClient(testServer.URL, tp.Client())
+
+	req, _ := c.NewRequest(context.Background(), http.MethodGet, ".", nil)
+	if _, err := c.Do(req, nil); err == nil {
+		t.Error("Expected an error")
+	}
+	if tp.SessionObject != nil {
+		t.Errorf("Expected no session, got %v", tp.SessionObject)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
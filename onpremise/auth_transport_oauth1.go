@@ -0,0 +1,436 @@
+package onpremise
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Endpoints of the Jira OAuth 1.0a provider, relative to the Jira base URL.
+const (
+	oauth1RequestTokenPath = "plugins/servlet/oauth/request-token"
+	oauth1AuthorizePath    = "plugins/servlet/oauth/authorize"
+	oauth1AccessTokenPath  = "plugins/servlet/oauth/access-token"
+)
+
+// OAuth1OutOfBand is the callback used if no CallbackURL is configured.
+// The user is shown the verification code after authorizing the request token.
+const OAuth1OutOfBand = "oob"
+
+// oauth1Nonce and oauth1Now are variables, so that tests can produce stable signatures.
+var (
+	oauth1Nonce = func() string {
+		b := make([]byte, 16)
+		if _, err := rand.Read(b); err != nil {
+			panic(fmt.Sprintf("oauth1: reading random bytes failed: %v", err))
+		}
+		return hex.EncodeToString(b)
+	}
+	oauth1Now = time.Now
+)
+
+// OAuth1Config describes an OAuth 1.0a consumer configured as an incoming application link in Jira.
+//
+// Jira docs: https://developer.atlassian.com/server/jira/platform/oauth/
+type OAuth1Config struct {
+	// BaseURL of the Jira instance, e.g. "https://jira.example.com/".
+	BaseURL string
+
+	// ConsumerKey of the application link.
+	ConsumerKey string
+
+	// PrivateKey matching the public key that was configured for the application link.
+	// See ParseOAuth1PrivateKey.
+	PrivateKey *rsa.PrivateKey
+
+	// CallbackURL the user is redirected to after authorizing the request token.
+	// It will default to OAuth1OutOfBand if empty.
+	CallbackURL string
+
+	// HTTPClient is used to retrieve the tokens.
+	// It will default to http.DefaultClient if nil.
+	HTTPClient *http.Client
+}
+
+// OAuth1Token is an OAuth 1.0a request or access token.
+type OAuth1Token struct {
+	Token  string `json:"token"`
+	Secret string `json:"secret"`
+}
+
+// ParseOAuth1PrivateKey parses a PEM encoded RSA private key (PKCS #1 or PKCS #8),
+// e.g. generated by
+//
+//	openssl genrsa -out jira_privatekey.pem 2048
+func ParseOAuth1PrivateKey(pemBytes []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, errors.New("oauth1: no PEM block found")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("oauth1: parsing private key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("oauth1: private key is of type %T, expected an RSA key", key)
+	}
+	return rsaKey, nil
+}
+
+// RequestToken retrieves a temporary request token.
+// This is the first step of the authorization. The user needs to authorize the request token
+// at the AuthorizationURL, before it can be exchanged for an access token with AccessToken.
+func (c *OAuth1Config) RequestToken(ctx context.Context) (*OAuth1Token, error) {
+	callback := c.CallbackURL
+	if callback == "" {
+		callback = OAuth1OutOfBand
+	}
+	return c.retrieveToken(ctx, oauth1RequestTokenPath, nil, map[string]string{"oauth_callback": callback})
+}
+
+// AuthorizationURL returns the URL the user needs to visit to authorize requestToken.
+func (c *OAuth1Config) AuthorizationURL(requestToken *OAuth1Token) (string, error) {
+	u, err := c.endpoint(oauth1AuthorizePath)
+	if err != nil {
+		return "", err
+	}
+	u.RawQuery = url.Values{"oauth_token": {requestToken.Token}}.Encode()
+	return u.String(), nil
+}
+
+// AccessToken exchanges the authorized requestToken for an access token.
+// verifier is the verification code shown to the user (out-of-band)
+// or the oauth_verifier parameter passed to the CallbackURL.
+func (c *OAuth1Config) AccessToken(ctx context.Context, requestToken *OAuth1Token, verifier string) (*OAuth1Token, error) {
+	return c.retrieveToken(ctx, oauth1AccessTokenPath, requestToken, map[string]string{"oauth_verifier": verifier})
+}
+
+func (c *OAuth1Config) retrieveToken(ctx context.Context, path string, token *OAuth1Token, extra map[string]string) (*OAuth1Token, error) {
+	u, err := c.endpoint(path)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.sign(req, token, extra); err != nil {
+		return nil, err
+	}
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("oauth1: token request failed with status code %d: %s", resp.StatusCode, string(body))
+	}
+
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, fmt.Errorf("oauth1: parsing token response: %w", err)
+	}
+	if problem := values.Get("oauth_problem"); problem != "" {
+		return nil, fmt.Errorf("oauth1: token request failed: %s", problem)
+	}
+	result := &OAuth1Token{Token: values.Get("oauth_token"), Secret: values.Get("oauth_token_secret")}
+	if result.Token == "" {
+		return nil, errors.New("oauth1: server response is missing oauth_token")
+	}
+	return result, nil
+}
+
+func (c *OAuth1Config) endpoint(path string) (*url.URL, error) {
+	baseURL, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(baseURL.Path, "/") {
+		baseURL.Path += "/"
+	}
+	return baseURL.ResolveReference(&url.URL{Path: path}), nil
+}
+
+// sign adds the OAuth Authorization header with an RSA-SHA1 signature to req.
+//
+// RFC: https://www.rfc-editor.org/rfc/rfc5849#section-3
+func (c *OAuth1Config) sign(req *http.Request, token *OAuth1Token, extra map[string]string) error {
+	if c.PrivateKey == nil {
+		return errors.New("oauth1: PrivateKey is nil")
+	}
+
+	params := map[string]string{
+		"oauth_consumer_key":     c.ConsumerKey,
+		"oauth_nonce":            oauth1Nonce(),
+		"oauth_signature_method": "RSA-SHA1",
+		"oauth_timestamp":        strconv.FormatInt(oauth1Now().Unix(), 10),
+		"oauth_version":          "1.0",
+	}
+	if token != nil && token.Token != "" {
+		params["oauth_token"] = token.Token
+	}
+	for k, v := range extra {
+		params[k] = v
+	}
+
+	base, err := oauth1SignatureBase(req, params)
+	if err != nil {
+		return err
+	}
+	h := sha1.Sum([]byte(base))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, c.PrivateKey, crypto.SHA1, h[:])
+	if err != nil {
+		return fmt.Errorf("oauth1: signing request: %w", err)
+	}
+	params["oauth_signature"] = base64.StdEncoding.EncodeToString(signature)
+
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s=\"%s\"", oauth1Escape(k), oauth1Escape(params[k])))
+	}
+	req.Header.Set("Authorization", "OAuth "+strings.Join(parts, ", "))
+	return nil
+}
+
+// oauth1SignatureBase returns the signature base string of req.
+// The parameters consist of the oauth parameters, the query parameters and,
+// for form encoded requests, the body parameters.
+func oauth1SignatureBase(req *http.Request, oauthParams map[string]string) (string, error) {
+	var pairs []string
+	add := func(k, v string) {
+		pairs = append(pairs, oauth1Escape(k)+"="+oauth1Escape(v))
+	}
+	for k, v := range oauthParams {
+		add(k, v)
+	}
+	for k, vs := range req.URL.Query() {
+		for _, v := range vs {
+			add(k, v)
+		}
+	}
+	if req.Body != nil && req.GetBody != nil && strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		body, err := req.GetBody()
+		if err != nil {
+			return "", err
+		}
+		b, err := io.ReadAll(body)
+		body.Close()
+		if err != nil {
+			return "", err
+		}
+		form, err := url.ParseQuery(string(b))
+		if err != nil {
+			return "", err
+		}
+		for k, vs := range form {
+			for _, v := range vs {
+				add(k, v)
+			}
+		}
+	}
+	sort.Strings(pairs)
+
+	u := *req.URL
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	if (u.Scheme == "http" && u.Port() == "80") || (u.Scheme == "https" && u.Port() == "443") {
+		u.Host = u.Hostname()
+	}
+	u.RawQuery = ""
+	u.Fragment = ""
+
+	return strings.Join([]string{
+		oauth1Escape(strings.ToUpper(req.Method)),
+		oauth1Escape(u.String()),
+		oauth1Escape(strings.Join(pairs, "&")),
+	}, "&"), nil
+}
+
+// oauth1Escape percent encodes s as required by RFC 5849, section 3.6.
+func oauth1Escape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') || c == '-' || c == '.' || c == '_' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// OAuth1TokenStore persists OAuth 1.0a access tokens.
+type OAuth1TokenStore interface {
+	// Load returns the stored token, or nil if no token was stored yet.
+	Load(ctx context.Context) (*OAuth1Token, error)
+	// Save stores token.
+	Save(ctx context.Context, token *OAuth1Token) error
+}
+
+// OAuth1FileTokenStore is an OAuth1TokenStore that keeps the token as JSON in a file.
+type OAuth1FileTokenStore struct {
+	Path string
+}
+
+// Load implements the OAuth1TokenStore interface.
+func (s *OAuth1FileTokenStore) Load(ctx context.Context) (*OAuth1Token, error) {
+	b, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	token := new(OAuth1Token)
+	if err := json.Unmarshal(b, token); err != nil {
+		return nil, fmt.Errorf("oauth1: decoding token file %s: %w", s.Path, err)
+	}
+	return token, nil
+}
+
+// Save implements the OAuth1TokenStore interface.
+// The file is only readable by the current user.
+func (s *OAuth1FileTokenStore) Save(ctx context.Context, token *OAuth1Token) error {
+	b, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.Path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(s.Path, b, 0o600)
+}
+
+// OAuth1Transport is an http.RoundTripper that signs all requests with OAuth 1.0a (RSA-SHA1),
+// as used by Jira application links.
+//
+// The access token is retrieved once via the OAuth1Config:
+//
+//	config := &jira.OAuth1Config{BaseURL: "https://jira.example.com/", ConsumerKey: "...", PrivateKey: key}
+//	requestToken, err := config.RequestToken(ctx)
+//	authURL, err := config.AuthorizationURL(requestToken)
+//	// let the user visit authURL and enter the verification code
+//	accessToken, err := config.AccessToken(ctx, requestToken, verifier)
+//
+//	tp := &jira.OAuth1Transport{Config: config, Token: accessToken}
+//	client, err := jira.NewClient("https://jira.example.com/", tp.Client())
+//
+// Jira docs: https://developer.atlassian.com/server/jira/platform/oauth/
+type OAuth1Transport struct {
+	Config *OAuth1Config
+
+	// Token is the access token.
+	// If nil, it is loaded from the Store.
+	Token *OAuth1Token
+
+	// Store persists the access token.
+	// Use SetToken to store a new token.
+	Store OAuth1TokenStore
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	mu sync.Mutex
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *OAuth1Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Config == nil {
+		return nil, errors.New("oauth1: Config is nil")
+	}
+	token, err := t.token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	req2 := cloneRequest(req) // per RoundTripper contract
+	if err := t.Config.sign(req2, token, nil); err != nil {
+		return nil, err
+	}
+	return t.transport().RoundTrip(req2)
+}
+
+// SetToken sets the access token and saves it in the Store, if set.
+func (t *OAuth1Transport) SetToken(ctx context.Context, token *OAuth1Token) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.Token = token
+	if t.Store != nil {
+		return t.Store.Save(ctx, token)
+	}
+	return nil
+}
+
+// Client returns an *http.Client that makes requests that are authenticated
+// using OAuth 1.0a.
+func (t *OAuth1Transport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *OAuth1Transport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+func (t *OAuth1Transport) token(ctx context.Context) (*OAuth1Token, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.Token != nil {
+		return t.Token, nil
+	}
+	if t.Store != nil {
+		token, err := t.Store.Load(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("oauth1: loading token: %w", err)
+		}
+		t.Token = token
+	}
+	if t.Token == nil {
+		return nil, errors.New("oauth1: no access token available")
+	}
+	return t.Token, nil
+}
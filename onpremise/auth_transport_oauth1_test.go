@@ -0,0 +1,234 @@
+package onpremise
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+var (
+	testOAuth1KeyOnce sync.Once
+	testOAuth1Key     *rsa.PrivateKey
+)
+
+func testOAuth1PrivateKey(t *testing.T) *rsa.PrivateKey {
+	testOAuth1KeyOnce.Do(func() {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatalf("Error generating RSA key: %s", err)
+		}
+		testOAuth1Key = key
+	})
+	return testOAuth1Key
+}
+
+// oauth1Params parses the parameters of an OAuth Authorization header.
+func oauth1Params(header string) map[string]string {
+	params := make(map[string]string)
+	for _, part := range strings.Split(strings.TrimPrefix(header, "OAuth "), ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		v, _ = url.PathUnescape(strings.Trim(v, "\""))
+		params[k] = v
+	}
+	return params
+}
+
+// testOAuth1Signature verifies the OAuth 1.0a signature of r and returns its oauth parameters.
+func testOAuth1Signature(t *testing.T, r *http.Request, key *rsa.PrivateKey) map[string]string {
+	t.Helper()
+	params := oauth1Params(r.Header.Get("Authorization"))
+	signature, err := base64.StdEncoding.DecodeString(params["oauth_signature"])
+	if err != nil {
+		t.Fatalf("Invalid signature encoding: %s", err)
+	}
+	delete(params, "oauth_signature")
+
+	// The request URL on the server side only contains the path
+	r2 := r.Clone(context.Background())
+	r2.URL.Scheme = "http"
+	r2.URL.Host = r.Host
+	base, err := oauth1SignatureBase(r2, params)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	h := sha1.Sum([]byte(base))
+	if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA1, h[:], signature); err != nil {
+		t.Errorf("Invalid signature for base string %q: %s", base, err)
+	}
+	if params["oauth_signature_method"] != "RSA-SHA1" {
+		t.Errorf("Expected signature method RSA-SHA1, got %q", params["oauth_signature_method"])
+	}
+	return params
+}
+
+func TestOAuth1Config_Dance(t *testing.T) {
+	setup()
+	defer teardown()
+
+	key := testOAuth1PrivateKey(t)
+
+	testMux.HandleFunc("/plugins/servlet/oauth/request-token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		params := testOAuth1Signature(t, r, key)
+		if params["oauth_consumer_key"] != "consumer" || params["oauth_callback"] != OAuth1OutOfBand {
+			t.Errorf("Unexpected request token parameters: %v", params)
+		}
+		fmt.Fprint(w, "oauth_token=request-token&oauth_token_secret=request-secret")
+	})
+	testMux.HandleFunc("/plugins/servlet/oauth/access-token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		params := testOAuth1Signature(t, r, key)
+		if params["oauth_token"] != "request-token" || params["oauth_verifier"] != "verifier" {
+			t.Errorf("Unexpected access token parameters: %v", params)
+		}
+		fmt.Fprint(w, "oauth_token=access-token&oauth_token_secret=access-secret")
+	})
+
+	config := &OAuth1Config{BaseURL: testServer.URL, ConsumerKey: "consumer", PrivateKey: key}
+
+	requestToken, err := config.RequestToken(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if requestToken.Token != "request-token" || requestToken.Secret != "request-secret" {
+		t.Errorf("Unexpected request token: %+v", requestToken)
+	}
+
+	authURL, err := config.AuthorizationURL(requestToken)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if want := testServer.URL + "/plugins/servlet/oauth/authorize?oauth_token=request-token"; authURL != want {
+		t.Errorf("Expected authorization URL %s, got %s", want, authURL)
+	}
+
+	accessToken, err := config.AccessToken(context.Background(), requestToken, "verifier")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if accessToken.Token != "access-token" {
+		t.Errorf("Unexpected access token: %+v", accessToken)
+	}
+}
+
+func TestOAuth1Config_RequestToken_Problem(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/plugins/servlet/oauth/request-token", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, "oauth_problem=consumer_key_unknown")
+	})
+
+	config := &OAuth1Config{BaseURL: testServer.URL, ConsumerKey: "unknown", PrivateKey: testOAuth1PrivateKey(t)}
+	if _, err := config.RequestToken(context.Background()); err == nil {
+		t.Error("Expected an error")
+	}
+}
+
+func TestOAuth1Transport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	key := testOAuth1PrivateKey(t)
+
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		params := testOAuth1Signature(t, r, key)
+		if params["oauth_token"] != "access-token" {
+			t.Errorf("Expected access token, got %v", params)
+		}
+		fmt.Fprint(w, `{"issues":[]}`)
+	})
+
+	tp := &OAuth1Transport{
+		Config: &OAuth1Config{BaseURL: testServer.URL, ConsumerKey: "consumer", PrivateKey: key},
+		Token:  &OAuth1Token{Token: "access-token", Secret: "access-secret"},
+	}
+	c, _ := NewClient(testServer.URL, tp.Client())
+
+	// the query parameters are part of the signature
+	if _, _, err := c.Issue.Search(context.Background(), "project = EX AND summary ~ \"a b\"", nil); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestOAuth1Transport_Store(t *testing.T) {
+	store := &OAuth1FileTokenStore{Path: filepath.Join(t.TempDir(), "jira", "token.json")}
+
+	tp := &OAuth1Transport{
+		Config: &OAuth1Config{PrivateKey: testOAuth1PrivateKey(t)},
+		Store:  store,
+	}
+	if _, err := tp.token(context.Background()); err == nil {
+		t.Error("Expected an error without token")
+	}
+
+	if err := tp.SetToken(context.Background(), &OAuth1Token{Token: "access-token", Secret: "access-secret"}); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	tp2 := &OAuth1Transport{Config: tp.Config, Store: store}
+	token, err := tp2.token(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if token.Token != "access-token" || token.Secret != "access-secret" {
+		t.Errorf("Unexpected stored token: %+v", token)
+	}
+}
+
+func TestParseOAuth1PrivateKey(t *testing.T) {
+	key := testOAuth1PrivateKey(t)
+
+	pkcs1 := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	if parsed, err := ParseOAuth1PrivateKey(pkcs1); err != nil || !parsed.Equal(key) {
+		t.Errorf("Parsing PKCS #1 key failed: %v", err)
+	}
+
+	b, _ := x509.MarshalPKCS8PrivateKey(key)
+	pkcs8 := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: b})
+	if parsed, err := ParseOAuth1PrivateKey(pkcs8); err != nil || !parsed.Equal(key) {
+		t.Errorf("Parsing PKCS #8 key failed: %v", err)
+	}
+
+	if _, err := ParseOAuth1PrivateKey([]byte("no pem")); err == nil {
+		t.Error("Expected an error for invalid input")
+	}
+}
+
+func TestOAuth1Escape(t *testing.T) {
+	if got, want := oauth1Escape("a b+c~d/é"), "a%20b%2Bc~d%2F%C3%A9"; got != want {
+		t.Errorf("oauth1Escape returned %q, want %q", got, want)
+	}
+}
+
+func TestOAuth1Transport_transport(t *testing.T) {
+	// default transport
+	tp := &OAuth1Transport{}
+	if tp.transport() != http.DefaultTransport {
+		t.Errorf("Expected http.DefaultTransport to be used.")
+	}
+
+	// custom transport
+	tp = &OAuth1Transport{
+		Transport: &http.Transport{},
+	}
+	if tp.transport() == http.DefaultTransport {
+		t.Errorf("Expected custom transport to be used.")
+	}
+}
@@ -0,0 +1,58 @@
+package onpremise
+
+import (
+	"context"
+	"time"
+
+	"github.com/andygrunwald/go-jira/v2/internal/core"
+)
+
+// CacheHeader is set on responses that have been served from the cache.
+const CacheHeader = core.CacheHeader
+
+// CacheMode controls how the CacheTransport treats a single request.
+// See WithCacheMode.
+type CacheMode = core.CacheMode
+
+const (
+	// CacheModeDefault serves fresh entries from the cache and stores new responses.
+	CacheModeDefault = core.CacheModeDefault
+	// CacheModeBypass neither reads from nor writes to the cache.
+	CacheModeBypass = core.CacheModeBypass
+	// CacheModeRefresh always asks Jira and replaces the cached entry with the response.
+	CacheModeRefresh = core.CacheModeRefresh
+)
+
+// WithCacheMode returns a copy of ctx that makes the CacheTransport
+// handle requests sent with it according to mode.
+func WithCacheMode(ctx context.Context, mode CacheMode) context.Context {
+	return core.WithCacheMode(ctx, mode)
+}
+
+// WithCacheTTL returns a copy of ctx that makes the CacheTransport cache
+// GET responses for ttl, even if the endpoint is not covered by one of its rules.
+func WithCacheTTL(ctx context.Context, ttl time.Duration) context.Context {
+	return core.WithCacheTTL(ctx, ttl)
+}
+
+// CacheRule defines how long responses of an endpoint are cached.
+type CacheRule = core.CacheRule
+
+// DefaultCacheRules covers the metadata endpoints that rarely change,
+// like fields, priorities, statuses, resolutions, issue link types and the create meta data.
+var DefaultCacheRules = core.DefaultCacheRules
+
+// CacheTransport is an http.RoundTripper that caches the responses of GET requests
+// of metadata endpoints, see DefaultCacheRules.
+//
+// The cache is looked up before the request reaches the underlying Transport,
+// so the authentication of the Transport does not keep the responses of different users apart.
+// Use a Cache per user, or set the username as Partition if a Cache is shared:
+//
+//	tp := &jira.CacheTransport{
+//		Cache:     sharedCache,
+//		Partition: username,
+//		Transport: &jira.BasicAuthTransport{Username: "...", Password: "..."},
+//	}
+//	client, err := jira.NewClient("https://jira.example.com/", tp.Client())
+type CacheTransport = core.CacheTransport
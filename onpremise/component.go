@@ -2,6 +2,7 @@ package onpremise
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 )
 
@@ -9,6 +10,13 @@ import (
 // Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/component
 type ComponentService service
 
+const (
+	AssigneeTypeProjectLead    = "PROJECT_LEAD"
+	AssigneeTypeComponentLead  = "COMPONENT_LEAD"
+	AssigneeTypeUnassigned     = "UNASSIGNED"
+	AssigneeTypeProjectDefault = "PROJECT_DEFAULT"
+)
+
 // CreateComponentOptions are passed to the ComponentService.Create function to create a new Jira component
 type CreateComponentOptions struct {
 	Name         string `json:"name,omitempty" structs:"name,omitempty"`
@@ -21,6 +29,12 @@ type CreateComponentOptions struct {
 	ProjectID    int    `json:"projectId,omitempty" structs:"projectId,omitempty"`
 }
 
+// ComponentDeleteOptions specifies the optional parameters of ComponentService.Delete.
+type ComponentDeleteOptions struct {
+	// MoveIssuesTo is the ID of the component the issues of the deleted component are moved to.
+	MoveIssuesTo string `url:"moveIssuesTo,omitempty"`
+}
+
 // Create creates a new Jira component based on the given options.
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
@@ -41,3 +55,115 @@ func (s *ComponentService) Create(ctx context.Context, options *CreateComponentO
 
 	return component, resp, nil
 }
+
+// Get returns a component for the given componentID.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/component-getComponent
+func (s *ComponentService) Get(ctx context.Context, componentID string) (*ProjectComponent, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/component/%s", componentID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	component := new(ProjectComponent)
+	resp, err := s.client.Do(req, component)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return component, resp, nil
+}
+
+// Update updates a component.
+// Only the set options are updated, the project can't be changed.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/component-updateComponent
+func (s *ComponentService) Update(ctx context.Context, componentID string, options *CreateComponentOptions) (*ProjectComponent, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/component/%s", componentID)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	component := new(ProjectComponent)
+	resp, err := s.client.Do(req, component)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return component, resp, nil
+}
+
+// Delete deletes a component.
+// The issues of the component are moved to the component options.MoveIssuesTo, if set.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/component-delete
+func (s *ComponentService) Delete(ctx context.Context, componentID string, options *ComponentDeleteOptions) (*Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/api/2/component/%s", componentID), options)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// GetRelatedIssueCounts returns the number of issues assigned to a component.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/component-getComponentRelatedIssues
+func (s *ComponentService) GetRelatedIssueCounts(ctx context.Context, componentID string) (int, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/component/%s/relatedIssueCounts", componentID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	result := struct {
+		IssueCount int `json:"issueCount"`
+	}{}
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return 0, resp, NewJiraError(resp, err)
+	}
+
+	return result.IssueCount, resp, nil
+}
+
+// ListByProject returns all components of a project.
+// Jira Server / Data Center has no paginated variant of this endpoint.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/project-getProjectComponents
+func (s *ComponentService) ListByProject(ctx context.Context, projectID string) ([]ProjectComponent, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/components", projectID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var components []ProjectComponent
+	resp, err := s.client.Do(req, &components)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return components, resp, nil
+}
+
+// ResolvedAssignee returns the user that issues created with the component are assigned to,
+// as resolved by Jira from the AssigneeType (see RealAssigneeType and RealAssignee).
+// It returns nil if the issues are unassigned.
+func (c *ProjectComponent) ResolvedAssignee() *User {
+	if c.RealAssigneeType == AssigneeTypeUnassigned || (c.RealAssignee.Key == "" && c.RealAssignee.Name == "") {
+		return nil
+	}
+	return &c.RealAssignee
+}
@@ -2,8 +2,10 @@ package onpremise
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
 	"testing"
 )
 
@@ -28,3 +30,118 @@ func TestComponentService_Create_Success(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestComponentService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/component/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body) != 1 || body["assigneeType"] != AssigneeTypeComponentLead {
+			t.Errorf("Unexpected request body: %v", body)
+		}
+		fmt.Fprint(w, `{"id":"10000","name":"Component 1","assigneeType":"COMPONENT_LEAD"}`)
+	})
+
+	component, _, err := testClient.Component.Update(context.Background(), "10000", &CreateComponentOptions{AssigneeType: AssigneeTypeComponentLead})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if component.AssigneeType != AssigneeTypeComponentLead {
+		t.Errorf("Unexpected component: %+v", component)
+	}
+}
+
+func TestComponentService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/component/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, "/rest/api/2/component/10000?moveIssuesTo=10001")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Component.Delete(context.Background(), "10000", &ComponentDeleteOptions{MoveIssuesTo: "10001"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestComponentService_GetRelatedIssueCounts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/component/10000/relatedIssueCounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/component/10000","issueCount":23}`)
+	})
+
+	count, _, err := testClient.Component.GetRelatedIssueCounts(context.Background(), "10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if count != 23 {
+		t.Errorf("Expected 23 issues, got %d", count)
+	}
+}
+
+func TestComponentService_ListByProject(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/project/EX/components", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":"10000","name":"Component 1"},{"id":"10050","name":"PXA"}]`)
+	})
+
+	components, _, err := testClient.Component.ListByProject(context.Background(), "EX")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(components) != 2 || components[1].Name != "PXA" {
+		t.Errorf("Unexpected components: %+v", components)
+	}
+}
+
+func TestProjectComponent_ResolvedAssignee(t *testing.T) {
+	component := &ProjectComponent{
+		AssigneeType:        AssigneeTypeComponentLead,
+		IsAssigneeTypeValid: false,
+		RealAssigneeType:    AssigneeTypeProjectLead,
+		RealAssignee:        User{Name: "fred"},
+	}
+	if assignee := component.ResolvedAssignee(); assignee == nil || assignee.Name != "fred" {
+		t.Errorf("Expected the project lead fred, got %+v", assignee)
+	}
+
+	component = &ProjectComponent{AssigneeType: AssigneeTypeUnassigned, RealAssigneeType: AssigneeTypeUnassigned}
+	if assignee := component.ResolvedAssignee(); assignee != nil {
+		t.Errorf("Expected no assignee, got %+v", assignee)
+	}
+}
+
+func TestComponentService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/component/42102"
+
+	raw, err := os.ReadFile("../testing/mock-data/component_get.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, string(raw))
+	})
+
+	component, _, err := testClient.Component.Get(context.Background(), "42102")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if component == nil || component.Name != "Some Component" {
+		t.Errorf("Unexpected component: %+v", component)
+	}
+}
@@ -5,6 +5,10 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/andygrunwald/go-jira/v2/internal/core"
 )
 
 // GroupService handles Groups for the Jira instance / API.
@@ -18,11 +22,18 @@ type groupMembersResult struct {
 	StartAt    int           `json:"startAt"`
 	MaxResults int           `json:"maxResults"`
 	Total      int           `json:"total"`
+	IsLast     bool          `json:"isLast"`
 	Members    []GroupMember `json:"values"`
 }
 
+func (r *groupMembersResult) Page() core.Page {
+	return core.Page{StartAt: r.StartAt, MaxResults: r.MaxResults, Total: r.Total, IsLast: r.IsLast}
+}
+
 // Group represents a Jira group
 type Group struct {
+	Name                 string          `json:"name,omitempty"`
+	Self                 string          `json:"self,omitempty"`
 	ID                   string          `json:"id"`
 	Title                string          `json:"title"`
 	Type                 string          `json:"type"`
@@ -58,6 +69,36 @@ type GroupSearchOptions struct {
 	IncludeInactiveUsers bool
 }
 
+// GroupDeleteOptions specifies the optional parameters of GroupService.Delete.
+type GroupDeleteOptions struct {
+	// SwapGroup is the name of the group that takes over the permissions and restrictions of the deleted group.
+	SwapGroup string `url:"swapGroup,omitempty"`
+}
+
+// GroupFindOptions specifies the optional parameters of GroupService.Find.
+type GroupFindOptions struct {
+	// Exclude are the names of groups left out of the result.
+	Exclude    []string `url:"exclude,omitempty"`
+	MaxResults int      `url:"maxResults,omitempty"`
+	// Username: the groups of this user are labeled in the result.
+	Username string `url:"userName,omitempty"`
+}
+
+// GroupSuggestion is a group found by GroupService.Find.
+type GroupSuggestion struct {
+	Name string `json:"name"`
+	// HTML is the name with the matching part highlighted, e.g. "<b>jira</b>-users".
+	HTML string `json:"html,omitempty"`
+}
+
+// GroupSyncResult is the result of GroupService.SyncMembers.
+type GroupSyncResult struct {
+	// Added are the usernames of the users added to the group.
+	Added []string
+	// Removed are the usernames of the users removed from the group.
+	Removed []string
+}
+
 // Get returns a paginated list of members of the specified group and its subgroups.
 // Users in the page are ordered by user names.
 // User of this resource is required to have sysadmin or admin permissions.
@@ -144,3 +185,140 @@ func (s *GroupService) Remove(ctx context.Context, groupname string, username st
 
 	return resp, nil
 }
+
+// Create creates a group.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/group-createGroup
+func (s *GroupService) Create(ctx context.Context, name string) (*Group, *Response, error) {
+	apiEndpoint := "rest/api/2/group"
+	body := struct {
+		Name string `json:"name"`
+	}{name}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	group := new(Group)
+	resp, err := s.client.Do(req, group)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return group, resp, nil
+}
+
+// Delete deletes the group with the given name.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/group-removeGroup
+func (s *GroupService) Delete(ctx context.Context, groupName string, options *GroupDeleteOptions) (*Response, error) {
+	if options == nil {
+		options = &GroupDeleteOptions{}
+	}
+	params := struct {
+		GroupName string `url:"groupname"`
+		*GroupDeleteOptions
+	}{groupName, options}
+	apiEndpoint, err := addOptions("rest/api/2/group", params)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Find returns the groups matching query, as shown in the group picker.
+// The number of matching groups is set as Response.Total.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/groups-findGroups
+func (s *GroupService) Find(ctx context.Context, query string, options *GroupFindOptions) ([]GroupSuggestion, *Response, error) {
+	if options == nil {
+		options = &GroupFindOptions{}
+	}
+	params := struct {
+		Query string `url:"query,omitempty"`
+		*GroupFindOptions
+	}{query, options}
+	apiEndpoint, err := addOptions("rest/api/2/groups/picker", params)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := struct {
+		Total  int               `json:"total"`
+		Groups []GroupSuggestion `json:"groups"`
+	}{}
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	resp.Total = result.Total
+	return result.Groups, resp, nil
+}
+
+// SyncMembers makes usernames the members of the group with the given name.
+// Users missing in the group are added, members not in usernames are removed, inactive ones included.
+// Usernames are compared case-insensitively, like Jira does.
+//
+// The changes are applied one by one. If one of them fails, the changes applied so far are returned with the error.
+func (s *GroupService) SyncMembers(ctx context.Context, groupName string, usernames []string) (*GroupSyncResult, error) {
+	// current maps the lower case usernames of the members to their usernames
+	current := make(map[string]string)
+	options := &GroupSearchOptions{MaxResults: 50, IncludeInactiveUsers: true}
+	for {
+		members, resp, err := s.Get(ctx, groupName, options)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range members {
+			current[strings.ToLower(m.Name)] = m.Name
+		}
+		options.StartAt += len(members)
+		if resp.IsLast || len(members) == 0 || options.StartAt >= resp.Total {
+			break
+		}
+	}
+
+	result := &GroupSyncResult{}
+	desired := make(map[string]bool, len(usernames))
+	for _, name := range usernames {
+		key := strings.ToLower(name)
+		if desired[key] {
+			continue
+		}
+		desired[key] = true
+		if _, ok := current[key]; ok {
+			continue
+		}
+		if _, _, err := s.Add(ctx, url.QueryEscape(groupName), name); err != nil {
+			return result, fmt.Errorf("adding %s to group %s: %w", name, groupName, err)
+		}
+		result.Added = append(result.Added, name)
+	}
+
+	var removed []string
+	for key, name := range current {
+		if !desired[key] {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(removed)
+	for _, name := range removed {
+		if _, err := s.Remove(ctx, url.QueryEscape(groupName), url.QueryEscape(name)); err != nil {
+			return result, fmt.Errorf("removing %s from group %s: %w", name, groupName, err)
+		}
+		result.Removed = append(result.Removed, name)
+	}
+	return result, nil
+}
@@ -2,6 +2,7 @@ package onpremise
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -95,3 +96,123 @@ func TestGroupService_Remove(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestGroupService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, "/rest/api/2/group")
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"jira-testers","self":"http://www.example.com/jira/rest/api/2/group?groupname=jira-testers","users":{"size":0,"items":[],"max-results":50,"start-index":0,"end-index":0},"expand":"users"}`)
+	})
+
+	group, _, err := testClient.Group.Create(context.Background(), "jira-testers")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if group.Name != "jira-testers" {
+		t.Errorf("Unexpected group: %+v", group)
+	}
+}
+
+func TestGroupService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestParams(t, r, map[string]string{"groupname": "jira testers", "swapGroup": "jira-users"})
+	})
+
+	if _, err := testClient.Group.Delete(context.Background(), "jira testers", &GroupDeleteOptions{SwapGroup: "jira-users"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestGroupService_Find(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/groups/picker", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"query": "jira", "exclude": "jira-administrators", "maxResults": "10"})
+		fmt.Fprint(w, `{"header":"Showing 2 of 2 matching groups","total":2,"groups":[
+			{"name":"jira-software-users","html":"<b>jira</b>-software-users","labels":[]},
+			{"name":"jira-testers","html":"<b>jira</b>-testers","labels":[]}]}`)
+	})
+
+	groups, resp, err := testClient.Group.Find(context.Background(), "jira", &GroupFindOptions{Exclude: []string{"jira-administrators"}, MaxResults: 10})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(groups) != 2 || groups[1].Name != "jira-testers" || groups[1].HTML != "<b>jira</b>-testers" {
+		t.Errorf("Unexpected groups: %+v", groups)
+	}
+	if resp.Total != 2 {
+		t.Errorf("Expected Total 2, got %d", resp.Total)
+	}
+}
+
+func TestGroupService_SyncMembers(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group/member", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("startAt") == "0" {
+			fmt.Fprint(w, `{"maxResults":2,"startAt":0,"total":3,"isLast":false,"values":[{"name":"alex"},{"name":"michael"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"maxResults":2,"startAt":2,"total":3,"isLast":true,"values":[{"name":"sara"}]}`)
+	})
+	var added, removed []string
+	testMux.HandleFunc("/rest/api/2/group/user", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("groupname") != "idp users" {
+			t.Errorf("Unexpected group name %q", r.URL.Query().Get("groupname"))
+		}
+		switch r.Method {
+		case http.MethodPost:
+			var user struct {
+				Name string `json:"name"`
+			}
+			json.NewDecoder(r.Body).Decode(&user)
+			added = append(added, user.Name)
+			fmt.Fprint(w, `{"name":"idp users"}`)
+		case http.MethodDelete:
+			removed = append(removed, r.URL.Query().Get("username"))
+		}
+	})
+
+	result, err := testClient.Group.SyncMembers(context.Background(), "idp users", []string{"michael", "lincoln", "lincoln"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if fmt.Sprint(result.Added) != "[lincoln]" || fmt.Sprint(result.Removed) != "[alex sara]" {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+	if fmt.Sprint(added) != "[lincoln]" || fmt.Sprint(removed) != "[alex sara]" {
+		t.Errorf("Unexpected requests: added %v, removed %v", added, removed)
+	}
+}
+
+func TestGroupService_SyncMembers_CaseInsensitive(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group/member", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"maxResults":50,"startAt":0,"total":2,"isLast":true,"values":[{"name":"JDoe"},{"name":"Alex"}]}`)
+	})
+	var removed []string
+	testMux.HandleFunc("/rest/api/2/group/user", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Unexpected %s request", r.Method)
+		}
+		removed = append(removed, r.URL.Query().Get("username"))
+	})
+
+	result, err := testClient.Group.SyncMembers(context.Background(), "idp users", []string{"jdoe"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(result.Added) != 0 || fmt.Sprint(result.Removed) != "[Alex]" || fmt.Sprint(removed) != "[Alex]" {
+		t.Errorf("Unexpected result: %+v (removed %v)", result, removed)
+	}
+}
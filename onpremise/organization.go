@@ -236,16 +236,17 @@ func (s *OrganizationService) GetProperty(ctx context.Context, organizationID in
 // SetProperty sets the value of a
 // property for an organization. Use this
 // resource to store custom data against an organization.
+// value can be anything that is encodable as JSON.
 //
 // https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-organization/#api-rest-servicedeskapi-organization-organizationid-property-propertykey-put
 // Caller must close resp.Body
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
-func (s *OrganizationService) SetProperty(ctx context.Context, organizationID int, propertyKey string) (*Response, error) {
+func (s *OrganizationService) SetProperty(ctx context.Context, organizationID int, propertyKey string, value interface{}) (*Response, error) {
 	apiEndPoint := fmt.Sprintf("rest/servicedeskapi/organization/%d/property/%s", organizationID, propertyKey)
 
-	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndPoint, nil)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndPoint, value)
 	req.Header.Set("Accept", "application/json")
 
 	if err != nil {
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/google/go-querystring/query"
 )
@@ -13,6 +16,13 @@ import (
 // Jira API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/project
 type ProjectService service
 
+// Project type keys
+const (
+	ProjectTypeSoftware    = "software"
+	ProjectTypeBusiness    = "business"
+	ProjectTypeServiceDesk = "service_desk"
+)
+
 // ProjectList represent a list of Projects
 type ProjectList []struct {
 	Expand          string          `json:"expand" structs:"expand"`
@@ -52,6 +62,82 @@ type Project struct {
 	Roles           map[string]string  `json:"roles,omitempty" structs:"roles,omitempty"`
 	AvatarUrls      AvatarUrls         `json:"avatarUrls,omitempty" structs:"avatarUrls,omitempty"`
 	ProjectCategory ProjectCategory    `json:"projectCategory,omitempty" structs:"projectCategory,omitempty"`
+	ProjectTypeKey  string             `json:"projectTypeKey,omitempty" structs:"projectTypeKey,omitempty"`
+}
+
+// ProjectCreateOptions are passed to the ProjectService.Create function to create a new Jira project
+type ProjectCreateOptions struct {
+	// Key: The project key. It must be unique, start with an uppercase letter
+	// and consist of uppercase letters and numbers. Required.
+	Key string `json:"key" structs:"key"`
+
+	// Name: The name of the project. Required.
+	Name string `json:"name" structs:"name"`
+
+	// ProjectTypeKey: The type of the project, e.g. ProjectTypeSoftware.
+	// Required unless ProjectTemplateKey is set.
+	ProjectTypeKey string `json:"projectTypeKey,omitempty" structs:"projectTypeKey,omitempty"`
+
+	// ProjectTemplateKey: The template the project is created from,
+	// e.g. "com.pyxis.greenhopper.jira:gh-scrum-template".
+	ProjectTemplateKey string `json:"projectTemplateKey,omitempty" structs:"projectTemplateKey,omitempty"`
+
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+
+	// Lead: The username of the project lead. Required.
+	Lead string `json:"lead,omitempty" structs:"lead,omitempty"`
+
+	URL string `json:"url,omitempty" structs:"url,omitempty"`
+
+	// AssigneeType: The default assignee of new issues, "PROJECT_LEAD" or "UNASSIGNED".
+	AssigneeType string `json:"assigneeType,omitempty" structs:"assigneeType,omitempty"`
+
+	AvatarID            int `json:"avatarId,omitempty" structs:"avatarId,omitempty"`
+	IssueSecurityScheme int `json:"issueSecurityScheme,omitempty" structs:"issueSecurityScheme,omitempty"`
+	PermissionScheme    int `json:"permissionScheme,omitempty" structs:"permissionScheme,omitempty"`
+	NotificationScheme  int `json:"notificationScheme,omitempty" structs:"notificationScheme,omitempty"`
+	CategoryID          int `json:"categoryId,omitempty" structs:"categoryId,omitempty"`
+}
+
+// ProjectUpdateOptions are passed to the ProjectService.Update function to update a Jira project.
+// Only the set fields are updated.
+type ProjectUpdateOptions struct {
+	Key                 string `json:"key,omitempty" structs:"key,omitempty"`
+	Name                string `json:"name,omitempty" structs:"name,omitempty"`
+	ProjectTypeKey      string `json:"projectTypeKey,omitempty" structs:"projectTypeKey,omitempty"`
+	Description         string `json:"description,omitempty" structs:"description,omitempty"`
+	Lead                string `json:"lead,omitempty" structs:"lead,omitempty"`
+	URL                 string `json:"url,omitempty" structs:"url,omitempty"`
+	AssigneeType        string `json:"assigneeType,omitempty" structs:"assigneeType,omitempty"`
+	AvatarID            int    `json:"avatarId,omitempty" structs:"avatarId,omitempty"`
+	IssueSecurityScheme int    `json:"issueSecurityScheme,omitempty" structs:"issueSecurityScheme,omitempty"`
+	PermissionScheme    int    `json:"permissionScheme,omitempty" structs:"permissionScheme,omitempty"`
+	NotificationScheme  int    `json:"notificationScheme,omitempty" structs:"notificationScheme,omitempty"`
+	CategoryID          int    `json:"categoryId,omitempty" structs:"categoryId,omitempty"`
+}
+
+// ProjectIdentifiers identifies a created project.
+type ProjectIdentifiers struct {
+	Self string `json:"self" structs:"self"`
+	ID   int    `json:"id" structs:"id"`
+	Key  string `json:"key" structs:"key"`
+}
+
+// ProjectSearchOptions specifies the optional parameters of ProjectService.Search.
+type ProjectSearchOptions struct {
+	StartAt int
+	// MaxResults: Maximum number of projects to return, all remaining projects if <= 0.
+	MaxResults int
+
+	// Query: Matches the key or name of the project, case insensitive.
+	Query      string
+	TypeKey    string
+	CategoryID int
+
+	// IncludeArchived: Include archived projects.
+	IncludeArchived bool
+
+	Expand string
 }
 
 // ProjectComponent represents a single component of a project
@@ -161,3 +247,180 @@ func (s *ProjectService) GetPermissionScheme(ctx context.Context, projectID stri
 
 	return ps, resp, nil
 }
+
+// Create creates a project from a project type or template.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/project-createProject
+func (s *ProjectService) Create(ctx context.Context, options *ProjectCreateOptions) (*ProjectIdentifiers, *Response, error) {
+	apiEndpoint := "rest/api/2/project"
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project := new(ProjectIdentifiers)
+	resp, err := s.client.Do(req, project)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return project, resp, nil
+}
+
+// Update updates the project with the given ID or key.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/project-updateProject
+func (s *ProjectService) Update(ctx context.Context, projectID string, options *ProjectUpdateOptions) (*Project, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s", projectID)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project := new(Project)
+	resp, err := s.client.Do(req, project)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return project, resp, nil
+}
+
+// Delete deletes the project with the given ID or key, including all its issues.
+// Unlike on Jira Cloud the deletion is synchronous and can't be undone.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/project-deleteProject
+func (s *ProjectService) Delete(ctx context.Context, projectID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s", projectID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Archive archives the project with the given ID or key.
+// Archiving projects requires Jira Data Center.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/project-archiveProject
+func (s *ProjectService) Archive(ctx context.Context, projectID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/archive", projectID)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Restore restores an archived project.
+// Archiving projects requires Jira Data Center.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/project-restoreProject
+func (s *ProjectService) Restore(ctx context.Context, projectID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/restore", projectID)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Search returns a page of the projects visible to the user, filtered by the options.
+// The paging values are set in the Response.
+//
+// Jira Server / Data Center has no paginated project search (like /rest/api/2/project/search on Jira Cloud),
+// so Search loads all projects and filters and pages them on the client.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/project-getAllProjects
+func (s *ProjectService) Search(ctx context.Context, options *ProjectSearchOptions) ([]Project, *Response, error) {
+	if options == nil {
+		options = &ProjectSearchOptions{}
+	}
+	apiEndpoint := "rest/api/2/project"
+	params := url.Values{}
+	if options.IncludeArchived {
+		params.Set("includeArchived", "true")
+	}
+	if options.Expand != "" {
+		params.Set("expand", options.Expand)
+	}
+	if len(params) > 0 {
+		apiEndpoint += "?" + params.Encode()
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var projects []Project
+	resp, err := s.client.Do(req, &projects)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	matches := make([]Project, 0, len(projects))
+	query := strings.ToLower(options.Query)
+	for _, p := range projects {
+		if query != "" && !strings.Contains(strings.ToLower(p.Key), query) && !strings.Contains(strings.ToLower(p.Name), query) {
+			continue
+		}
+		if options.TypeKey != "" && p.ProjectTypeKey != options.TypeKey {
+			continue
+		}
+		if options.CategoryID != 0 && p.ProjectCategory.ID != strconv.Itoa(options.CategoryID) {
+			continue
+		}
+		matches = append(matches, p)
+	}
+
+	start := options.StartAt
+	if start < 0 {
+		start = 0
+	}
+	if start > len(matches) {
+		start = len(matches)
+	}
+	end := len(matches)
+	if options.MaxResults > 0 && options.MaxResults < end-start {
+		end = start + options.MaxResults
+	}
+	resp.StartAt = start
+	resp.MaxResults = end - start
+	resp.Total = len(matches)
+	resp.IsLast = end == len(matches)
+	return matches[start:end], resp, nil
+}
+
+// AssignPermissionScheme assigns the permission scheme to the project with the given ID or key.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/project/{projectKeyOrId}/permissionscheme-assignPermissionScheme
+func (s *ProjectService) AssignPermissionScheme(ctx context.Context, projectID string, permissionSchemeID int) (*PermissionScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/permissionscheme", projectID)
+	body := struct {
+		ID int `json:"id"`
+	}{permissionSchemeID}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ps := new(PermissionScheme)
+	resp, err := s.client.Do(req, ps)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return ps, resp, nil
+}
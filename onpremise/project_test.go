@@ -2,9 +2,11 @@ package onpremise
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -137,3 +139,192 @@ func TestProjectService_GetPermissionScheme_Success(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestProjectService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/project", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["key"] != "EX" || body["projectTypeKey"] != ProjectTypeBusiness || body["lead"] != "charlie" {
+			t.Errorf("Unexpected request body: %v", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"self":"http://www.example.com/jira/rest/api/2/project/10042","id":10042,"key":"EX"}`)
+	})
+
+	project, _, err := testClient.Project.Create(context.Background(), &ProjectCreateOptions{
+		Key:            "EX",
+		Name:           "Example",
+		ProjectTypeKey: ProjectTypeBusiness,
+		Lead:           "charlie",
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if project.ID != 10042 || project.Key != "EX" {
+		t.Errorf("Unexpected project: %+v", project)
+	}
+}
+
+func TestProjectService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/project/EX", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body) != 1 || body["lead"] != "alice" {
+			t.Errorf("Unexpected request body: %v", body)
+		}
+		fmt.Fprint(w, `{"id":"10042","key":"EX","lead":{"name":"alice"}}`)
+	})
+
+	project, _, err := testClient.Project.Update(context.Background(), "EX", &ProjectUpdateOptions{Lead: "alice"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if project.Lead.Name != "alice" {
+		t.Errorf("Unexpected project: %+v", project)
+	}
+}
+
+func TestProjectService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/project/EX", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Project.Delete(context.Background(), "EX"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_ArchiveRestore(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/project/EX/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	testMux.HandleFunc("/rest/api/2/project/EX/restore", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	if _, err := testClient.Project.Archive(context.Background(), "EX"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Project.Restore(context.Background(), "EX"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_Search(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/project", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/project?includeArchived=true")
+		fmt.Fprint(w, `[
+			{"id":"1","key":"EX","name":"Example","projectTypeKey":"software","projectCategory":{"id":"10000"}},
+			{"id":"2","key":"OPS","name":"Operations","projectTypeKey":"software","projectCategory":{"id":"10000"}},
+			{"id":"3","key":"EX2","name":"Second example","projectTypeKey":"business","projectCategory":{"id":"10000"}},
+			{"id":"4","key":"EX3","name":"Third example","projectTypeKey":"software","projectCategory":{"id":"10000"}},
+			{"id":"5","key":"EX4","name":"Fourth example","projectTypeKey":"software","projectCategory":{"id":"10001"}}
+		]`)
+	})
+
+	projects, resp, err := testClient.Project.Search(context.Background(), &ProjectSearchOptions{
+		StartAt:         1,
+		MaxResults:      1,
+		Query:           "ex",
+		TypeKey:         ProjectTypeSoftware,
+		CategoryID:      10000,
+		IncludeArchived: true,
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(projects) != 1 || projects[0].Key != "EX3" {
+		t.Errorf("Unexpected projects: %+v", projects)
+	}
+	if resp.StartAt != 1 || resp.MaxResults != 1 || resp.Total != 2 || !resp.IsLast {
+		t.Errorf("Unexpected paging values: %+v", resp)
+	}
+}
+
+func TestProjectService_Search_Paging(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/project", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":"1","key":"EX"},{"id":"2","key":"OPS"},{"id":"3","key":"EX2"}]`)
+	})
+
+	tests := []struct {
+		name       string
+		startAt    int
+		maxResults int
+		wantKeys   string
+		wantStart  int
+		wantLast   bool
+	}{
+		{"all", 0, 0, "EX OPS EX2", 0, true},
+		{"first page", 0, 2, "EX OPS", 0, false},
+		{"last page", 2, 2, "EX2", 2, true},
+		{"negative start", -1, 2, "EX OPS", 0, false},
+		{"negative max results", 1, -1, "OPS EX2", 1, true},
+		{"start after the end", 5, 2, "", 3, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projects, resp, err := testClient.Project.Search(context.Background(), &ProjectSearchOptions{StartAt: tt.startAt, MaxResults: tt.maxResults})
+			if err != nil {
+				t.Fatalf("Error given: %s", err)
+			}
+			keys := make([]string, 0, len(projects))
+			for _, p := range projects {
+				keys = append(keys, p.Key)
+			}
+			if got := strings.Join(keys, " "); got != tt.wantKeys {
+				t.Errorf("Expected projects %q, got %q", tt.wantKeys, got)
+			}
+			if resp.StartAt != tt.wantStart || resp.Total != 3 || resp.IsLast != tt.wantLast {
+				t.Errorf("Unexpected paging values: %+v", resp)
+			}
+		})
+	}
+}
+
+func TestProjectService_AssignPermissionScheme(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/project/EX/permissionscheme", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		var body map[string]int
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["id"] != 10001 {
+			t.Errorf("Unexpected request body: %v", body)
+		}
+		fmt.Fprint(w, `{"id":10001,"name":"Example permission scheme"}`)
+	})
+
+	ps, _, err := testClient.Project.AssignPermissionScheme(context.Background(), "EX", 10001)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if ps.ID != 10001 {
+		t.Errorf("Unexpected permission scheme: %+v", ps)
+	}
+}
@@ -0,0 +1,170 @@
+package onpremise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// PropertyService handles entity properties for the Jira instance / API.
+//
+// Entity properties store arbitrary JSON data against issues, projects, users, comments, boards and sprints,
+// e.g. the integration state of an app.
+//
+// Jira API docs: https://developer.atlassian.com/server/jira/platform/entity-properties/
+type PropertyService service
+
+// PropertyEntity is the kind of entity a property is stored against.
+type PropertyEntity string
+
+// The entities supporting properties.
+// The entity ID of PropertyEntityUser is the username of the user,
+// the one of PropertyEntityIssue and PropertyEntityProject can be the ID or key.
+const (
+	PropertyEntityIssue   PropertyEntity = "issue"
+	PropertyEntityProject PropertyEntity = "project"
+	PropertyEntityUser    PropertyEntity = "user"
+	PropertyEntityComment PropertyEntity = "comment"
+	PropertyEntityBoard   PropertyEntity = "board"
+	PropertyEntitySprint  PropertyEntity = "sprint"
+)
+
+// propertyValue is the EntityProperty with the undecoded value, used by GetInto.
+type propertyValue struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+// propertyEndpoint returns the API endpoint of the property with the given key,
+// or of all properties if propertyKey is empty.
+func propertyEndpoint(entity PropertyEntity, entityID, propertyKey string) (string, error) {
+	var apiEndpoint string
+	switch entity {
+	case PropertyEntityIssue, PropertyEntityProject, PropertyEntityComment:
+		apiEndpoint = fmt.Sprintf("rest/api/2/%s/%s/properties", entity, url.PathEscape(entityID))
+	case PropertyEntityBoard, PropertyEntitySprint:
+		apiEndpoint = fmt.Sprintf("rest/agile/1.0/%s/%s/properties", entity, url.PathEscape(entityID))
+	case PropertyEntityUser:
+		apiEndpoint = "rest/api/2/user/properties"
+	default:
+		return "", fmt.Errorf("unsupported property entity %q", entity)
+	}
+
+	if propertyKey != "" {
+		apiEndpoint += "/" + url.PathEscape(propertyKey)
+	}
+	if entity == PropertyEntityUser {
+		apiEndpoint += "?username=" + url.QueryEscape(entityID)
+	}
+	return apiEndpoint, nil
+}
+
+// Keys returns the keys of all properties of an entity.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/issue/{issueIdOrKey}/properties-getPropertiesKeys
+func (s *PropertyService) Keys(ctx context.Context, entity PropertyEntity, entityID string) ([]PropertyKey, *Response, error) {
+	apiEndpoint, err := propertyEndpoint(entity, entityID, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := new(PropertyKeys)
+	resp, err := s.client.Do(req, keys)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return keys.Keys, resp, nil
+}
+
+// Get returns the property of an entity with the given key.
+// The value is decoded into generic JSON types, use GetInto to decode it into a struct.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/issue/{issueIdOrKey}/properties-getProperty
+func (s *PropertyService) Get(ctx context.Context, entity PropertyEntity, entityID, propertyKey string) (*EntityProperty, *Response, error) {
+	apiEndpoint, err := propertyEndpoint(entity, entityID, propertyKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(EntityProperty)
+	resp, err := s.client.Do(req, property)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return property, resp, nil
+}
+
+// GetInto decodes the value of the property of an entity with the given key into v.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/issue/{issueIdOrKey}/properties-getProperty
+func (s *PropertyService) GetInto(ctx context.Context, entity PropertyEntity, entityID, propertyKey string, v interface{}) (*Response, error) {
+	apiEndpoint, err := propertyEndpoint(entity, entityID, propertyKey)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	property := new(propertyValue)
+	resp, err := s.client.Do(req, property)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	if err := json.Unmarshal(property.Value, v); err != nil {
+		return resp, fmt.Errorf("decoding property %s: %w", propertyKey, err)
+	}
+	return resp, nil
+}
+
+// Set creates or replaces the property of an entity with the given key.
+// value can be anything that is encodable as JSON, e.g. a struct, a map or a json.RawMessage.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/issue/{issueIdOrKey}/properties-setProperty
+func (s *PropertyService) Set(ctx context.Context, entity PropertyEntity, entityID, propertyKey string, value interface{}) (*Response, error) {
+	apiEndpoint, err := propertyEndpoint(entity, entityID, propertyKey)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, value)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Delete removes the property of an entity with the given key.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/issue/{issueIdOrKey}/properties-deleteProperty
+func (s *PropertyService) Delete(ctx context.Context, entity PropertyEntity, entityID, propertyKey string) (*Response, error) {
+	apiEndpoint, err := propertyEndpoint(entity, entityID, propertyKey)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
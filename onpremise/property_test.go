@@ -0,0 +1,109 @@
+package onpremise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestPropertyService_Keys(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/properties", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1/properties")
+		fmt.Fprint(w, `{"keys":[{"self":"https://jira.example.com/rest/api/2/issue/EX-1/properties/issue.support","key":"issue.support"}]}`)
+	})
+
+	keys, _, err := testClient.Property.Keys(context.Background(), PropertyEntityIssue, "EX-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(keys) != 1 || keys[0].Key != "issue.support" {
+		t.Errorf("Unexpected keys: %+v", keys)
+	}
+}
+
+func TestPropertyService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/EX/properties/sync", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"key":"sync","value":{"enabled":true}}`)
+	})
+
+	property, _, err := testClient.Property.Get(context.Background(), PropertyEntityProject, "EX", "sync")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if property.Key != "sync" || property.Value.(map[string]interface{})["enabled"] != true {
+		t.Errorf("Unexpected property: %+v", property)
+	}
+}
+
+func TestPropertyService_GetInto(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/properties/sync", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"username": "fred"})
+		fmt.Fprint(w, `{"key":"sync","value":{"cursor":"abc","count":3}}`)
+	})
+
+	var state struct {
+		Cursor string `json:"cursor"`
+		Count  int    `json:"count"`
+	}
+	_, err := testClient.Property.GetInto(context.Background(), PropertyEntityUser, "fred", "sync", &state)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if state.Cursor != "abc" || state.Count != 3 {
+		t.Errorf("Unexpected value: %+v", state)
+	}
+}
+
+func TestPropertyService_Set(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/board/1/properties/sync", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		var value map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&value); err != nil {
+			t.Fatalf("Error decoding body: %s", err)
+		}
+		if value["cursor"] != "abc" {
+			t.Errorf("Unexpected value: %v", value)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := testClient.Property.Set(context.Background(), PropertyEntityBoard, "1", "sync", map[string]string{"cursor": "abc"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestPropertyService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/sprint/2/properties/sync", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Property.Delete(context.Background(), PropertyEntitySprint, "2", "sync"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestPropertyService_UnsupportedEntity(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if _, _, err := testClient.Property.Keys(context.Background(), PropertyEntity("dashboard"), "1"); err == nil {
+		t.Error("Expected an error for an unsupported entity")
+	}
+}
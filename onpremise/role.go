@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"path"
+	"sort"
+	"strconv"
 )
 
 // RoleService handles roles for the Jira instance / API.
@@ -20,6 +23,12 @@ type Role struct {
 	Actors      []*Actor `json:"actors" structs:"actors"`
 }
 
+// Actor types
+const (
+	ActorTypeUser  = "atlassian-user-role-actor"
+	ActorTypeGroup = "atlassian-group-role-actor"
+)
+
 // Actor represents a Jira actor
 type Actor struct {
 	ID          int        `json:"id" structs:"id"`
@@ -35,6 +44,40 @@ type ActorUser struct {
 	AccountID string `json:"accountId" structs:"accountId"`
 }
 
+// RoleCreateOptions are passed to the RoleService.Create and RoleService.Update functions
+type RoleCreateOptions struct {
+	// Name: The unique name of the role.
+	// Required when creating a role.
+	// Optional when updating a role.
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+
+	// Description: The description of the role.
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+}
+
+// RoleActors are the users and groups added to a role or set as its actors.
+type RoleActors struct {
+	// Users are the usernames of the users.
+	Users []string `json:"user,omitempty" structs:"user,omitempty"`
+	// Groups are the names of the groups.
+	Groups []string `json:"group,omitempty" structs:"group,omitempty"`
+}
+
+// RoleActorOptions identifies the single actor removed from a role.
+// Exactly one of the fields must be set.
+type RoleActorOptions struct {
+	// User is the username of the user.
+	User  string `url:"user,omitempty"`
+	Group string `url:"group,omitempty"`
+}
+
+// RoleDeleteOptions specifies the optional parameters of RoleService.Delete.
+type RoleDeleteOptions struct {
+	// Swap is the ID of the role replacing the deleted role in the permission, notification and issue security schemes.
+	// Required if the role is used in a scheme.
+	Swap int `url:"swap,omitempty"`
+}
+
 // GetList returns a list of all available project roles
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-api-3-role-get
@@ -80,3 +123,232 @@ func (s *RoleService) Get(ctx context.Context, roleID int) (*Role, *Response, er
 
 	return role, resp, err
 }
+
+// Create creates a role.
+// The role has no default actors.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/role-createProjectRole
+func (s *RoleService) Create(ctx context.Context, options *RoleCreateOptions) (*Role, *Response, error) {
+	apiEndpoint := "rest/api/2/role"
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(Role)
+	resp, err := s.client.Do(req, role)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return role, resp, nil
+}
+
+// Update updates the name and/or the description of a role.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/role-partialUpdateProjectRole
+func (s *RoleService) Update(ctx context.Context, roleID int, options *RoleCreateOptions) (*Role, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/role/%d", roleID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(Role)
+	resp, err := s.client.Do(req, role)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return role, resp, nil
+}
+
+// Delete deletes a role.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/role-deleteProjectRole
+func (s *RoleService) Delete(ctx context.Context, roleID int, options *RoleDeleteOptions) (*Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/api/2/role/%d", roleID), options)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// GetProjectRoles returns the roles of a project, without their actors.
+// Jira only returns the names and URLs of the roles, so only Self, Name and ID are set.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/project/{projectIdOrKey}/role-getProjectRoles
+func (s *RoleService) GetProjectRoles(ctx context.Context, projectID string) ([]Role, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/role", projectID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roleURLs map[string]string
+	resp, err := s.client.Do(req, &roleURLs)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	roles := make([]Role, 0, len(roleURLs))
+	for name, self := range roleURLs {
+		id, err := strconv.Atoi(path.Base(self))
+		if err != nil {
+			return nil, resp, fmt.Errorf("invalid URL %q of role %s", self, name)
+		}
+		roles = append(roles, Role{Self: self, Name: name, ID: id})
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i].ID < roles[j].ID })
+	return roles, resp, nil
+}
+
+// GetProjectRole returns a role of a project, including its actors.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/project/{projectIdOrKey}/role-getProjectRole
+func (s *RoleService) GetProjectRole(ctx context.Context, projectID string, roleID int) (*Role, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/role/%d", projectID, roleID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(Role)
+	resp, err := s.client.Do(req, role)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return role, resp, nil
+}
+
+// AddProjectActors adds users and groups to a role of a project.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/project/{projectIdOrKey}/role-addActorUsers
+func (s *RoleService) AddProjectActors(ctx context.Context, projectID string, roleID int, actors *RoleActors) (*Role, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/role/%d", projectID, roleID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, actors)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(Role)
+	resp, err := s.client.Do(req, role)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return role, resp, nil
+}
+
+// SetProjectActors replaces the actors of a role of a project.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/project/{projectIdOrKey}/role-setActors
+func (s *RoleService) SetProjectActors(ctx context.Context, projectID string, roleID int, actors *RoleActors) (*Role, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/role/%d", projectID, roleID)
+	categorisedActors := map[string][]string{
+		ActorTypeUser:  {},
+		ActorTypeGroup: {},
+	}
+	if actors != nil {
+		categorisedActors[ActorTypeUser] = append(categorisedActors[ActorTypeUser], actors.Users...)
+		categorisedActors[ActorTypeGroup] = append(categorisedActors[ActorTypeGroup], actors.Groups...)
+	}
+	body := struct {
+		ID                int                 `json:"id"`
+		CategorisedActors map[string][]string `json:"categorisedActors"`
+	}{roleID, categorisedActors}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(Role)
+	resp, err := s.client.Do(req, role)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return role, resp, nil
+}
+
+// RemoveProjectActor removes a user or group from a role of a project.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/project/{projectIdOrKey}/role-deleteActor
+func (s *RoleService) RemoveProjectActor(ctx context.Context, projectID string, roleID int, actor *RoleActorOptions) (*Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/api/2/project/%s/role/%d", projectID, roleID), actor)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// GetDefaultActors returns the default actors of a role.
+// They are added to the role of every new project.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/role-getProjectRoleActorsForRole
+func (s *RoleService) GetDefaultActors(ctx context.Context, roleID int) (*Role, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/role/%d/actors", roleID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(Role)
+	resp, err := s.client.Do(req, role)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return role, resp, nil
+}
+
+// AddDefaultActors adds default actors to a role.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/role-addProjectRoleActorsToRole
+func (s *RoleService) AddDefaultActors(ctx context.Context, roleID int, actors *RoleActors) (*Role, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/role/%d/actors", roleID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, actors)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(Role)
+	resp, err := s.client.Do(req, role)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return role, resp, nil
+}
+
+// RemoveDefaultActor removes a default actor from a role.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/role-deleteProjectRoleActorsFromRole
+func (s *RoleService) RemoveDefaultActor(ctx context.Context, roleID int, actor *RoleActorOptions) (*Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/api/2/role/%d/actors", roleID), actor)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
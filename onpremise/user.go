@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
 // UserService handles users for the Jira instance / API.
@@ -44,6 +46,25 @@ type userSearch []userSearchParam
 
 type userSearchF func(userSearch) userSearch
 
+// encode returns the parameters as escaped query string.
+func (s userSearch) encode() string {
+	values := url.Values{}
+	for _, param := range s {
+		values.Add(param.name, param.value)
+	}
+	return values.Encode()
+}
+
+// UserSuggestion is a user found by UserService.FindForPicker.
+type UserSuggestion struct {
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	Key         string `json:"key,omitempty" structs:"key,omitempty"`
+	DisplayName string `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	AvatarURL   string `json:"avatarUrl,omitempty" structs:"avatarUrl,omitempty"`
+	// HTML is the display name with the matching part highlighted, e.g. "<strong>Mi</strong>a Krystof".
+	HTML string `json:"html,omitempty" structs:"html,omitempty"`
+}
+
 // Get gets user info from Jira using its Account Id
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-user-get
@@ -255,6 +276,32 @@ func WithProperty(property string) userSearchF {
 	}
 }
 
+// WithProject restricts the search to users of a project (FindAssignable and FindWithPermissions)
+func WithProject(projectKey string) userSearchF {
+	return func(s userSearch) userSearch {
+		s = append(s, userSearchParam{name: "project", value: projectKey})
+		return s
+	}
+}
+
+// WithIssueKey restricts the search to users of an issue (FindAssignable and FindWithPermissions)
+func WithIssueKey(issueKey string) userSearchF {
+	return func(s userSearch) userSearch {
+		s = append(s, userSearchParam{name: "issueKey", value: issueKey})
+		return s
+	}
+}
+
+// WithExclude excludes users by their username (FindForPicker)
+func WithExclude(usernames ...string) userSearchF {
+	return func(s userSearch) userSearch {
+		for _, name := range usernames {
+			s = append(s, userSearchParam{name: "exclude", value: name})
+		}
+		return s
+	}
+}
+
 // Find searches for user info from Jira:
 // It can find users by email or display name using the query parameter
 //
@@ -291,3 +338,77 @@ func (s *UserService) Find(ctx context.Context, property string, tweaks ...userS
 	}
 	return users, resp, nil
 }
+
+// FindAssignable returns the users matching username (the username, name or email address)
+// that can be assigned to issues of the project (WithProject) or to the issue (WithIssueKey).
+// One of them is required.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/user-findAssignableUsers
+func (s *UserService) FindAssignable(ctx context.Context, username string, tweaks ...userSearchF) ([]User, *Response, error) {
+	search := userSearch{{name: "username", value: username}}
+	for _, f := range tweaks {
+		search = f(search)
+	}
+	return s.findUsers(ctx, "rest/api/2/user/assignable/search", search)
+}
+
+// FindWithPermissions returns the users matching username (the username, name or email address) that have all permissions,
+// e.g. "BROWSE_PROJECTS" or "EDIT_ISSUES", globally, in the project (WithProject) or for the issue (WithIssueKey).
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/user-findUsersWithAllPermissions
+func (s *UserService) FindWithPermissions(ctx context.Context, username string, permissions []string, tweaks ...userSearchF) ([]User, *Response, error) {
+	search := userSearch{{name: "username", value: username}, {name: "permissions", value: strings.Join(permissions, ",")}}
+	for _, f := range tweaks {
+		search = f(search)
+	}
+	// This endpoint names the project parameter projectKey
+	for i := range search {
+		if search[i].name == "project" {
+			search[i].name = "projectKey"
+		}
+	}
+	return s.findUsers(ctx, "rest/api/2/user/permission/search", search)
+}
+
+func (s *UserService) findUsers(ctx context.Context, endpoint string, search userSearch) ([]User, *Response, error) {
+	apiEndpoint := endpoint + "?" + search.encode()
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	users := []User{}
+	resp, err := s.client.Do(req, &users)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return users, resp, nil
+}
+
+// FindForPicker returns the users matching query, as shown in the user picker.
+// The number of matching users is set as Response.Total.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/user-findUsersForPicker
+func (s *UserService) FindForPicker(ctx context.Context, query string, tweaks ...userSearchF) ([]UserSuggestion, *Response, error) {
+	search := userSearch{{name: "query", value: query}}
+	for _, f := range tweaks {
+		search = f(search)
+	}
+
+	apiEndpoint := "rest/api/2/user/picker?" + search.encode()
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := struct {
+		Total int              `json:"total"`
+		Users []UserSuggestion `json:"users"`
+	}{}
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	resp.Total = result.Total
+	return result.Users, resp, nil
+}
@@ -191,3 +191,61 @@ func TestUserService_Find_SuccessParams(t *testing.T) {
 		t.Error("Expected user. User is nil")
 	}
 }
+
+func TestUserService_FindAssignable(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/assignable/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"username": "fred", "issueKey": "EX-1"})
+		fmt.Fprint(w, `[{"name":"fred","key":"fred","displayName":"Fred F. User","active":true}]`)
+	})
+
+	users, _, err := testClient.User.FindAssignable(context.Background(), "fred", WithIssueKey("EX-1"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 1 || users[0].Name != "fred" {
+		t.Errorf("Unexpected users: %+v", users)
+	}
+}
+
+func TestUserService_FindWithPermissions(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/permission/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"username": "fred", "permissions": "BROWSE_PROJECTS,EDIT_ISSUES", "projectKey": "EX"})
+		fmt.Fprint(w, `[{"name":"fred","key":"fred","displayName":"Fred F. User","active":true}]`)
+	})
+
+	users, _, err := testClient.User.FindWithPermissions(context.Background(), "fred", []string{"BROWSE_PROJECTS", "EDIT_ISSUES"}, WithProject("EX"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 1 || users[0].DisplayName != "Fred F. User" {
+		t.Errorf("Unexpected users: %+v", users)
+	}
+}
+
+func TestUserService_FindForPicker(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/picker", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"query": "fr", "exclude": "admin"})
+		fmt.Fprint(w, `{"header":"Showing 1 of 1 matching users","total":1,"users":[
+			{"name":"fred","key":"fred","html":"<strong>Fr</strong>ed F. User","displayName":"Fred F. User"}]}`)
+	})
+
+	users, resp, err := testClient.User.FindForPicker(context.Background(), "fr", WithExclude("admin"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 1 || users[0].Name != "fred" || users[0].HTML != "<strong>Fr</strong>ed F. User" {
+		t.Errorf("Unexpected users: %+v", users)
+	}
+	if resp.Total != 1 {
+		t.Errorf("Expected Total 1, got %d", resp.Total)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+
+	"github.com/andygrunwald/go-jira/v2/internal/core"
 )
 
 // VersionService handles Versions for the Jira instance / API.
@@ -13,18 +15,31 @@ import (
 type VersionService service
 
 // Version represents a single release version of a project
-type Version struct {
-	Self            string `json:"self,omitempty" structs:"self,omitempty"`
-	ID              string `json:"id,omitempty" structs:"id,omitempty"`
-	Name            string `json:"name,omitempty" structs:"name,omitempty"`
-	Description     string `json:"description,omitempty" structs:"description,omitempty"`
-	Archived        *bool  `json:"archived,omitempty" structs:"archived,omitempty"`
-	Released        *bool  `json:"released,omitempty" structs:"released,omitempty"`
-	ReleaseDate     string `json:"releaseDate,omitempty" structs:"releaseDate,omitempty"`
-	UserReleaseDate string `json:"userReleaseDate,omitempty" structs:"userReleaseDate,omitempty"`
-	ProjectID       int    `json:"projectId,omitempty" structs:"projectId,omitempty"` // Unlike other IDs, this is returned as a number
-	StartDate       string `json:"startDate,omitempty" structs:"startDate,omitempty"`
-}
+type Version = core.Version
+
+// Version positions, see VersionService.Move
+const (
+	VersionPositionFirst   = core.VersionPositionFirst
+	VersionPositionLast    = core.VersionPositionLast
+	VersionPositionEarlier = core.VersionPositionEarlier
+	VersionPositionLater   = core.VersionPositionLater
+)
+
+// VersionDeleteOptions specifies the optional parameters of VersionService.Delete.
+type VersionDeleteOptions = core.VersionDeleteOptions
+
+// VersionMoveOptions are passed to VersionService.Move.
+// Exactly one of the fields must be set.
+type VersionMoveOptions = core.VersionMoveOptions
+
+// VersionListOptions specifies the optional parameters of VersionService.ListByProject.
+type VersionListOptions = core.VersionListOptions
+
+// VersionIssueCounts are the numbers of issues related to a version.
+type VersionIssueCounts = core.VersionIssueCounts
+
+// VersionUnresolvedIssueCount is the number of unresolved issues of a version.
+type VersionUnresolvedIssueCount = core.VersionUnresolvedIssueCount
 
 // Get gets version info from Jira
 //
@@ -99,3 +114,67 @@ func (s *VersionService) Update(ctx context.Context, version *Version) (*Version
 	ret := *version
 	return &ret, resp, nil
 }
+
+// Delete deletes a version.
+// The issues with the version as fix version or affected version are moved
+// to the versions set in options, otherwise the version is removed from them.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/version-delete
+func (s *VersionService) Delete(ctx context.Context, versionID string, options *VersionDeleteOptions) (*Response, error) {
+	return core.DeleteVersion(ctx, s.client, versionID, options)
+}
+
+// Merge merges a version into the version moveIssuesTo and deletes it.
+// The fix versions and affected versions of all issues are updated.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/version-merge
+func (s *VersionService) Merge(ctx context.Context, versionID, moveIssuesTo string) (*Response, error) {
+	return core.MergeVersion(ctx, s.client, versionID, moveIssuesTo)
+}
+
+// Move changes the position of a version in the list of versions of its project.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/version-moveVersion
+func (s *VersionService) Move(ctx context.Context, versionID string, options *VersionMoveOptions) (*Version, *Response, error) {
+	return core.MoveVersion(ctx, s.client, versionID, options)
+}
+
+// ListByProject returns a page of the versions of a project.
+// The paging values are set in the Response.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/project/{projectIdOrKey}/version-getProjectVersionsPaginated
+func (s *VersionService) ListByProject(ctx context.Context, projectID string, options *VersionListOptions) ([]Version, *Response, error) {
+	return core.ListVersionsByProject(ctx, s.client, projectID, options)
+}
+
+// GetRelatedIssueCounts returns the number of issues with the version as fix version and as affected version.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/version-getVersionRelatedIssues
+func (s *VersionService) GetRelatedIssueCounts(ctx context.Context, versionID string) (*VersionIssueCounts, *Response, error) {
+	return core.GetVersionRelatedIssueCounts(ctx, s.client, versionID)
+}
+
+// GetUnresolvedIssueCount returns the number of unresolved issues with the version as fix version.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/version-getVersionUnresolvedIssues
+func (s *VersionService) GetUnresolvedIssueCount(ctx context.Context, versionID string) (*VersionUnresolvedIssueCount, *Response, error) {
+	return core.GetVersionUnresolvedIssueCount(ctx, s.client, versionID)
+}
+
+// Release marks a version as released, like the release dialog of Jira.
+// The release date is set to today, unless the version has a ReleaseDate.
+// The unresolved issues of the version are moved to the version moveUnresolvedTo, if it isn't nil.
+// Otherwise they keep the released version as fix version.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/version-updateVersion
+func (s *VersionService) Release(ctx context.Context, version *Version, moveUnresolvedTo *Version) (*Version, *Response, error) {
+	return core.ReleaseVersion(ctx, s.client, s.client.BaseURL, version, moveUnresolvedTo)
+}
+
+// Archive marks a version as archived.
+// Archived versions can't be selected as fix version or affected version anymore.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/version-updateVersion
+func (s *VersionService) Archive(ctx context.Context, versionID string) (*Version, *Response, error) {
+	return core.ArchiveVersion(ctx, s.client, versionID)
+}
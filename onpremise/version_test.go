@@ -2,9 +2,12 @@ package onpremise
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestVersionService_Get_Success(t *testing.T) {
@@ -111,3 +114,60 @@ func TestServiceService_Update(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestVersionService_ListByProject(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/project/EX/version", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/project/EX/version?maxResults=2&orderBy=-releaseDate&status=unreleased")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":2,"total":3,"isLast":false,"values":[
+			{"id":"10000","name":"1.1","released":false},
+			{"id":"10001","name":"1.2","released":false}
+		]}`)
+	})
+
+	versions, resp, err := testClient.Version.ListByProject(context.Background(), "EX", &VersionListOptions{
+		MaxResults: 2,
+		OrderBy:    "-releaseDate",
+		Status:     "unreleased",
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(versions) != 2 || versions[1].Name != "1.2" {
+		t.Errorf("Unexpected versions: %+v", versions)
+	}
+	if resp.Total != 3 || resp.IsLast {
+		t.Errorf("Unexpected paging values: %+v", resp)
+	}
+}
+
+func TestVersionService_Release(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/version/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		want := map[string]interface{}{
+			"released":            true,
+			"releaseDate":         time.Now().Format("2006-01-02"),
+			"moveUnfixedIssuesTo": testServer.URL + "/rest/api/2/version/10001",
+		}
+		if !reflect.DeepEqual(body, want) {
+			t.Errorf("Request body %v, want %v", body, want)
+		}
+		fmt.Fprint(w, `{"id":"10000","name":"1.1","released":true}`)
+	})
+
+	version, _, err := testClient.Version.Release(context.Background(), &Version{ID: "10000", Name: "1.1"}, &Version{ID: "10001"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if version.Released == nil || !*version.Released {
+		t.Errorf("Expected the version to be released: %+v", version)
+	}
+}
@@ -0,0 +1,137 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+)
+
+// WebhookService handles the admin webhooks of the Jira instance / API.
+// Managing webhooks requires the Jira Administrators global permission.
+// Webhooks are received with the handler of the package onpremise/webhook.
+//
+// Jira API docs: https://developer.atlassian.com/server/jira/platform/webhooks/
+type WebhookService service
+
+// Webhook represents an admin webhook.
+type Webhook struct {
+	Self string `json:"self,omitempty" structs:"self,omitempty"`
+	Name string `json:"name" structs:"name"`
+	URL  string `json:"url" structs:"url"`
+
+	// Events the webhook is sent for, e.g. "jira:issue_created".
+	Events []string `json:"events" structs:"events"`
+
+	Filters     *WebhookFilters `json:"filters,omitempty" structs:"filters,omitempty"`
+	ExcludeBody bool            `json:"excludeBody" structs:"excludeBody"`
+	Enabled     bool            `json:"enabled" structs:"enabled"`
+
+	LastUpdatedUser        string `json:"lastUpdatedUser,omitempty" structs:"lastUpdatedUser,omitempty"`
+	LastUpdatedDisplayName string `json:"lastUpdatedDisplayName,omitempty" structs:"lastUpdatedDisplayName,omitempty"`
+	// LastUpdated in milliseconds since the Unix epoch.
+	LastUpdated int64 `json:"lastUpdated,omitempty" structs:"lastUpdated,omitempty"`
+}
+
+// WebhookFilters restricts the issues a webhook is sent for.
+type WebhookFilters struct {
+	// IssueRelatedEvents is a JQL query, e.g. "project = EX".
+	IssueRelatedEvents string `json:"issue-related-events-section,omitempty" structs:"issue-related-events-section,omitempty"`
+}
+
+// ID returns the ID of the webhook, which is the last segment of Self.
+func (w *Webhook) ID() string {
+	if w.Self == "" {
+		return ""
+	}
+	return path.Base(w.Self)
+}
+
+// GetList returns all webhooks.
+//
+// Jira API docs: https://developer.atlassian.com/server/jira/platform/webhooks/#registering-a-webhook-via-the-jira-rest-api
+func (s *WebhookService) GetList(ctx context.Context) ([]Webhook, *Response, error) {
+	apiEndpoint := "rest/webhooks/1.0/webhook"
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var webhooks []Webhook
+	resp, err := s.client.Do(req, &webhooks)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return webhooks, resp, nil
+}
+
+// Get returns the webhook with the given ID.
+//
+// Jira API docs: https://developer.atlassian.com/server/jira/platform/webhooks/#registering-a-webhook-via-the-jira-rest-api
+func (s *WebhookService) Get(ctx context.Context, webhookID string) (*Webhook, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/webhooks/1.0/webhook/%s", webhookID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhook := new(Webhook)
+	resp, err := s.client.Do(req, webhook)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return webhook, resp, nil
+}
+
+// Create registers a webhook and returns it, including its Self link.
+//
+// Jira API docs: https://developer.atlassian.com/server/jira/platform/webhooks/#registering-a-webhook-via-the-jira-rest-api
+func (s *WebhookService) Create(ctx context.Context, webhook *Webhook) (*Webhook, *Response, error) {
+	apiEndpoint := "rest/webhooks/1.0/webhook"
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, webhook)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(Webhook)
+	resp, err := s.client.Do(req, created)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return created, resp, nil
+}
+
+// Update replaces the webhook with the given ID.
+//
+// Jira API docs: https://developer.atlassian.com/server/jira/platform/webhooks/#registering-a-webhook-via-the-jira-rest-api
+func (s *WebhookService) Update(ctx context.Context, webhookID string, webhook *Webhook) (*Webhook, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/webhooks/1.0/webhook/%s", webhookID)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, webhook)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(Webhook)
+	resp, err := s.client.Do(req, updated)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return updated, resp, nil
+}
+
+// Delete removes the webhook with the given ID.
+//
+// Jira API docs: https://developer.atlassian.com/server/jira/platform/webhooks/#registering-a-webhook-via-the-jira-rest-api
+func (s *WebhookService) Delete(ctx context.Context, webhookID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/webhooks/1.0/webhook/%s", webhookID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,92 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+)
+
+const issueUpdatedPayload = `{
+	"timestamp": 1700000000000,
+	"webhookEvent": "jira:issue_updated",
+	"issue_event_type_name": "issue_generic",
+	"user": {"name": "mia", "displayName": "Mia Krystof"},
+	"issue": {"id": "10002", "key": "EX-1", "fields": {"summary": "Bug", "customfield_10010": "value"}},
+	"changelog": {"id": "10100", "items": [{"field": "status", "fromString": "To Do", "toString": "In Progress"}]}
+}`
+
+func TestParseEvent_Issue(t *testing.T) {
+	v, err := ParseEvent([]byte(issueUpdatedPayload))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	e, ok := v.(*IssueEvent)
+	if !ok {
+		t.Fatalf("Expected an *IssueEvent, got %T", v)
+	}
+
+	if e.WebhookEvent != EventIssueUpdated || e.IssueEventTypeName != "issue_generic" {
+		t.Errorf("Unexpected event: %+v", e.Event)
+	}
+	if !e.Time().Equal(time.UnixMilli(1700000000000)) {
+		t.Errorf("Unexpected time %v", e.Time())
+	}
+	if e.User.Name != "mia" {
+		t.Errorf("Unexpected user: %+v", e.User)
+	}
+	if e.Issue.Key != "EX-1" || e.Issue.Fields.Summary != "Bug" || e.Issue.Fields.Unknowns["customfield_10010"] != "value" {
+		t.Errorf("Unexpected issue: %+v", e.Issue)
+	}
+	if len(e.Changelog.Items) != 1 || e.Changelog.Items[0].ToString != "In Progress" {
+		t.Errorf("Unexpected changelog: %+v", e.Changelog)
+	}
+	if len(e.Payload) == 0 {
+		t.Error("Expected the raw payload to be set")
+	}
+}
+
+func TestParseEvent_Types(t *testing.T) {
+	tests := []struct {
+		payload string
+		check   func(v interface{}) bool
+	}{
+		{
+			`{"webhookEvent":"comment_created","comment":{"id":"10000","body":"Hello"},"issue":{"key":"EX-1"}}`,
+			func(v interface{}) bool {
+				e, ok := v.(*CommentEvent)
+				return ok && e.Comment.Body == "Hello" && e.Issue.Key == "EX-1"
+			},
+		},
+		{
+			`{"webhookEvent":"worklog_updated","worklog":{"id":"10001","issueId":"10002","timeSpentSeconds":3600}}`,
+			func(v interface{}) bool { e, ok := v.(*WorklogEvent); return ok && e.Worklog.TimeSpentSeconds == 3600 },
+		},
+		{
+			`{"webhookEvent":"sprint_started","sprint":{"id":1,"name":"Sprint 1","state":"active"}}`,
+			func(v interface{}) bool { e, ok := v.(*SprintEvent); return ok && e.Sprint.Name == "Sprint 1" },
+		},
+		{
+			`{"webhookEvent":"project_created","project":{"key":"EX"}}`,
+			func(v interface{}) bool {
+				e, ok := v.(*Event)
+				return ok && e.WebhookEvent == "project_created" && len(e.Payload) > 0
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		v, err := ParseEvent([]byte(tt.payload))
+		if err != nil {
+			t.Errorf("Error given for %s: %s", tt.payload, err)
+			continue
+		}
+		if !tt.check(v) {
+			t.Errorf("Unexpected event %#v for %s", v, tt.payload)
+		}
+	}
+}
+
+func TestParseEvent_Invalid(t *testing.T) {
+	if _, err := ParseEvent([]byte(`not json`)); err == nil {
+		t.Error("Expected an error")
+	}
+}
@@ -0,0 +1,119 @@
+package onpremise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+const testWebhookJSON = `{
+	"self": "https://jira.example.com/rest/webhooks/1.0/webhook/12",
+	"name": "my first webhook",
+	"url": "https://app.example.com/webhook",
+	"events": ["jira:issue_created", "jira:issue_updated"],
+	"filters": {"issue-related-events-section": "project = EX"},
+	"excludeBody": false,
+	"enabled": true,
+	"lastUpdatedUser": "admin",
+	"lastUpdated": 1589005800000
+}`
+
+func TestWebhookService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/webhooks/1.0/webhook", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, "["+testWebhookJSON+"]")
+	})
+
+	webhooks, _, err := testClient.Webhook.GetList(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(webhooks) != 1 {
+		t.Fatalf("Expected 1 webhook, got %d", len(webhooks))
+	}
+	if webhooks[0].ID() != "12" || webhooks[0].Filters.IssueRelatedEvents != "project = EX" || !webhooks[0].Enabled {
+		t.Errorf("Unexpected webhook: %+v", webhooks[0])
+	}
+}
+
+func TestWebhookService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/webhooks/1.0/webhook/12", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, testWebhookJSON)
+	})
+
+	webhook, _, err := testClient.Webhook.Get(context.Background(), "12")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if webhook.Name != "my first webhook" || len(webhook.Events) != 2 {
+		t.Errorf("Unexpected webhook: %+v", webhook)
+	}
+}
+
+func TestWebhookService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/webhooks/1.0/webhook", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		filters, _ := body["filters"].(map[string]interface{})
+		if body["name"] != "my first webhook" || filters["issue-related-events-section"] != "project = EX" {
+			t.Errorf("Unexpected request body: %v", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, testWebhookJSON)
+	})
+
+	webhook, _, err := testClient.Webhook.Create(context.Background(), &Webhook{
+		Name:    "my first webhook",
+		URL:     "https://app.example.com/webhook",
+		Events:  []string{"jira:issue_created", "jira:issue_updated"},
+		Filters: &WebhookFilters{IssueRelatedEvents: "project = EX"},
+		Enabled: true,
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if webhook.ID() != "12" {
+		t.Errorf("Expected webhook ID 12, got %q", webhook.ID())
+	}
+}
+
+func TestWebhookService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/webhooks/1.0/webhook/12", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, testWebhookJSON)
+	})
+
+	if _, _, err := testClient.Webhook.Update(context.Background(), "12", &Webhook{Name: "my first webhook"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestWebhookService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/webhooks/1.0/webhook/12", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Webhook.Delete(context.Background(), "12"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,254 @@
+// Package releasenotes generates release notes for a project version
+// from the issues that have the version as fix version.
+//
+// The issues are grouped by issue type and component and rendered with a Template.
+// Markdown, HTML and JSON are built in, other formats can be plugged in
+// with TextTemplate, HTMLTemplate or TemplateFunc:
+//
+//	g := &releasenotes.Generator{
+//		Client:  client, // jira.Client, works with Jira Cloud and Jira Server / Data Center
+//		BaseURL: "https://your.atlassian.net/",
+//		Exclude: []string{"labels = internal", "resolution = Duplicate"},
+//	}
+//	err := g.Render(ctx, os.Stdout, "10000", releasenotes.Markdown)
+package releasenotes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	jira "github.com/andygrunwald/go-jira/v2"
+)
+
+// NoComponent is the component group of the issues without a component.
+const NoComponent = "No component"
+
+// searchFields are the issue fields needed for the release notes.
+var searchFields = []string{"summary", "issuetype", "components", "status", "resolution"}
+
+// Generator generates release notes.
+type Generator struct {
+	Client jira.Client
+
+	// BaseURL of the Jira instance used for the browse links of the issues, e.g. "https://your.atlassian.net/".
+	// It will be derived from the Self URL of the issues if empty.
+	BaseURL string
+
+	// Exclude are JQL conditions, e.g. "labels = internal".
+	// Issues matching any of them are left out of the release notes.
+	Exclude []string
+
+	// TypeOrder is the order of the issue type groups, e.g. []string{"Story", "Bug"}.
+	// Issue types not listed follow in alphabetical order.
+	TypeOrder []string
+}
+
+// Notes are the release notes of a version.
+type Notes struct {
+	Version Version     `json:"version"`
+	Types   []TypeGroup `json:"types"`
+
+	// Issues are all issues of the release notes, ordered by key.
+	Issues []Issue `json:"issues"`
+}
+
+// Version is the version the release notes are generated for.
+type Version struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Released    bool   `json:"released"`
+	ReleaseDate string `json:"releaseDate,omitempty"`
+}
+
+// TypeGroup are the issues of one issue type, grouped by component.
+type TypeGroup struct {
+	Type       string           `json:"type"`
+	Components []ComponentGroup `json:"components"`
+}
+
+// ComponentGroup are the issues of one component.
+// Issues with several components are part of the group of each component.
+type ComponentGroup struct {
+	Component string  `json:"component"`
+	Issues    []Issue `json:"issues"`
+}
+
+// Issue is an issue of the release notes.
+type Issue struct {
+	Key        string   `json:"key"`
+	Summary    string   `json:"summary"`
+	Type       string   `json:"type"`
+	Status     string   `json:"status,omitempty"`
+	Resolution string   `json:"resolution,omitempty"`
+	Components []string `json:"components,omitempty"`
+
+	// URL is the browse URL of the issue, e.g. "https://your.atlassian.net/browse/EX-1".
+	URL string `json:"url,omitempty"`
+}
+
+// Generate returns the release notes of the version with the given ID.
+func (g *Generator) Generate(ctx context.Context, versionID string) (*Notes, error) {
+	if g.Client == nil {
+		return nil, errors.New("releasenotes: no client configured")
+	}
+
+	version, err := g.Client.Version().Get(ctx, versionID)
+	if err != nil {
+		return nil, fmt.Errorf("releasenotes: getting version %s: %w", versionID, err)
+	}
+
+	excluded, err := g.excludedKeys(ctx, version.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	notes := &Notes{
+		Version: Version{
+			ID:          version.ID,
+			Name:        version.Name,
+			Description: version.Description,
+			Released:    version.Released,
+			ReleaseDate: version.ReleaseDate,
+		},
+	}
+	jql := fmt.Sprintf("fixVersion = %s ORDER BY key ASC", version.ID)
+	err = g.Client.Search().SearchPages(ctx, jql, &jira.SearchOptions{Fields: searchFields}, func(i jira.Issue) error {
+		if !excluded[i.Key] {
+			notes.Issues = append(notes.Issues, g.issue(&i))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("releasenotes: searching issues of version %s: %w", version.ID, err)
+	}
+
+	notes.Types = g.group(notes.Issues)
+	return notes, nil
+}
+
+// Render generates the release notes of the version with the given ID and writes them to w.
+// The Markdown template is used if tmpl is nil.
+func (g *Generator) Render(ctx context.Context, w io.Writer, versionID string, tmpl Template) error {
+	notes, err := g.Generate(ctx, versionID)
+	if err != nil {
+		return err
+	}
+	if tmpl == nil {
+		tmpl = Markdown
+	}
+	return tmpl.Render(w, notes)
+}
+
+// excludedKeys returns the keys of the issues of the version matching one of the Exclude conditions.
+// They are looked up with a separate search, because JQL conditions like "NOT (labels = internal)"
+// would also exclude issues without any label.
+func (g *Generator) excludedKeys(ctx context.Context, versionID string) (map[string]bool, error) {
+	excluded := make(map[string]bool)
+	if len(g.Exclude) == 0 {
+		return excluded, nil
+	}
+
+	conditions := make([]string, 0, len(g.Exclude))
+	for _, c := range g.Exclude {
+		conditions = append(conditions, "("+c+")")
+	}
+	jql := fmt.Sprintf("fixVersion = %s AND (%s)", versionID, strings.Join(conditions, " OR "))
+	err := g.Client.Search().SearchPages(ctx, jql, &jira.SearchOptions{Fields: []string{"summary"}}, func(i jira.Issue) error {
+		excluded[i.Key] = true
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("releasenotes: searching excluded issues of version %s: %w", versionID, err)
+	}
+	return excluded, nil
+}
+
+func (g *Generator) issue(i *jira.Issue) Issue {
+	issue := Issue{
+		Key:        i.Key,
+		Summary:    i.Summary,
+		Type:       i.Type,
+		Status:     i.Status,
+		Resolution: i.Resolution,
+		Components: i.Components,
+	}
+	if base := g.baseURL(i.Self); base != "" {
+		issue.URL = base + "browse/" + i.Key
+	}
+	return issue
+}
+
+// baseURL returns the BaseURL with a trailing slash,
+// or the part of self in front of the REST API path if BaseURL is empty.
+func (g *Generator) baseURL(self string) string {
+	if g.BaseURL != "" {
+		return strings.TrimSuffix(g.BaseURL, "/") + "/"
+	}
+	if i := strings.Index(self, "/rest/api/"); i >= 0 {
+		return self[:i+1]
+	}
+	return ""
+}
+
+// group groups issues by type and component.
+// The types are ordered by TypeOrder, the components alphabetically with NoComponent last.
+func (g *Generator) group(issues []Issue) []TypeGroup {
+	byType := make(map[string]map[string][]Issue)
+	for _, issue := range issues {
+		components := issue.Components
+		if len(components) == 0 {
+			components = []string{NoComponent}
+		}
+		if byType[issue.Type] == nil {
+			byType[issue.Type] = make(map[string][]Issue)
+		}
+		for _, c := range components {
+			byType[issue.Type][c] = append(byType[issue.Type][c], issue)
+		}
+	}
+
+	types := make([]string, 0, len(byType))
+	for t := range byType {
+		types = append(types, t)
+	}
+	rank := func(t string) int {
+		for i, o := range g.TypeOrder {
+			if o == t {
+				return i
+			}
+		}
+		return len(g.TypeOrder)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		if ri, rj := rank(types[i]), rank(types[j]); ri != rj {
+			return ri < rj
+		}
+		return types[i] < types[j]
+	})
+
+	groups := make([]TypeGroup, 0, len(types))
+	for _, t := range types {
+		components := make([]string, 0, len(byType[t]))
+		for c := range byType[t] {
+			components = append(components, c)
+		}
+		sort.Slice(components, func(i, j int) bool {
+			if (components[i] == NoComponent) != (components[j] == NoComponent) {
+				return components[j] == NoComponent
+			}
+			return components[i] < components[j]
+		})
+
+		group := TypeGroup{Type: t}
+		for _, c := range components {
+			group.Components = append(group.Components, ComponentGroup{Component: c, Issues: byType[t][c]})
+		}
+		groups = append(groups, group)
+	}
+	return groups
+}
@@ -0,0 +1,209 @@
+package releasenotes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira/v2"
+)
+
+// testClient is a jira.Client serving a fixed version and search results by JQL.
+type testClient struct {
+	jira.Client
+
+	version *jira.Version
+	issues  map[string][]jira.Issue
+}
+
+func (c *testClient) Version() jira.VersionAPI { return (*testVersionAPI)(c) }
+func (c *testClient) Search() jira.SearchAPI   { return (*testSearchAPI)(c) }
+
+type testVersionAPI testClient
+
+func (a *testVersionAPI) Get(ctx context.Context, versionID string) (*jira.Version, error) {
+	if a.version == nil || a.version.ID != versionID {
+		return nil, errors.New("version not found")
+	}
+	return a.version, nil
+}
+
+func (a *testVersionAPI) ListByProject(ctx context.Context, projectIDOrKey string) ([]jira.Version, error) {
+	return []jira.Version{*a.version}, nil
+}
+
+type testSearchAPI testClient
+
+func (a *testSearchAPI) Search(ctx context.Context, jql string, options *jira.SearchOptions) (*jira.SearchResult, error) {
+	return &jira.SearchResult{Issues: a.issues[jql]}, nil
+}
+
+func (a *testSearchAPI) SearchPages(ctx context.Context, jql string, options *jira.SearchOptions, f func(jira.Issue) error) error {
+	result, _ := a.Search(ctx, jql, options)
+	for _, i := range result.Issues {
+		if err := f(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newTestClient() *testClient {
+	self := "https://jira.example.com/rest/api/2/issue/"
+	return &testClient{
+		version: &jira.Version{ID: "10000", Name: "1.0", ReleaseDate: "2026-10-01", Released: true},
+		issues: map[string][]jira.Issue{
+			"fixVersion = 10000 ORDER BY key ASC": {
+				{Key: "EX-1", Self: self + "1", Type: "Bug", Summary: "Crash on *empty* input", Components: []string{"API"}},
+				{Key: "EX-2", Self: self + "2", Type: "Story", Summary: "Export as CSV", Components: []string{"API", "UI"}},
+				{Key: "EX-3", Self: self + "3", Type: "Bug", Summary: "Typo in <title>"},
+				{Key: "EX-4", Self: self + "4", Type: "Task", Summary: "Internal cleanup", Components: []string{"UI"}},
+			},
+			"fixVersion = 10000 AND ((labels = internal))": {
+				{Key: "EX-4"},
+			},
+		},
+	}
+}
+
+func TestGenerator_Generate(t *testing.T) {
+	c := newTestClient()
+	g := &Generator{Client: c, Exclude: []string{"labels = internal"}, TypeOrder: []string{"Story"}}
+
+	notes, err := g.Generate(context.Background(), "10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if notes.Version.Name != "1.0" || !notes.Version.Released {
+		t.Errorf("Unexpected version: %+v", notes.Version)
+	}
+	if len(notes.Issues) != 3 {
+		t.Fatalf("Expected the excluded issue to be left out, got %+v", notes.Issues)
+	}
+	if want := "https://jira.example.com/browse/EX-1"; notes.Issues[0].URL != want {
+		t.Errorf("URL = %q, want %q", notes.Issues[0].URL, want)
+	}
+
+	var groups []string
+	for _, tg := range notes.Types {
+		for _, cg := range tg.Components {
+			var keys []string
+			for _, i := range cg.Issues {
+				keys = append(keys, i.Key)
+			}
+			groups = append(groups, tg.Type+"/"+cg.Component+":"+strings.Join(keys, ","))
+		}
+	}
+	want := "Story/API:EX-2 Story/UI:EX-2 Bug/API:EX-1 Bug/No component:EX-3"
+	if got := strings.Join(groups, " "); got != want {
+		t.Errorf("Groups = %q, want %q", got, want)
+	}
+}
+
+func TestGenerator_Generate_BaseURL(t *testing.T) {
+	g := &Generator{Client: newTestClient(), BaseURL: "https://example.atlassian.net"}
+
+	notes, err := g.Generate(context.Background(), "10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if want := "https://example.atlassian.net/browse/EX-1"; notes.Issues[0].URL != want {
+		t.Errorf("URL = %q, want %q", notes.Issues[0].URL, want)
+	}
+}
+
+func TestGenerator_Generate_UnknownVersion(t *testing.T) {
+	g := &Generator{Client: newTestClient()}
+	if _, err := g.Generate(context.Background(), "99999"); err == nil {
+		t.Error("Expected an error for an unknown version")
+	}
+}
+
+func TestGenerator_Render_Markdown(t *testing.T) {
+	g := &Generator{Client: newTestClient(), Exclude: []string{"labels = internal"}}
+
+	var buf bytes.Buffer
+	if err := g.Render(context.Background(), &buf, "10000", nil); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	want := `# 1.0 (2026-10-01)
+
+## Bug
+
+### API
+
+- [EX-1](https://jira.example.com/browse/EX-1) Crash on \*empty\* input
+
+### No component
+
+- [EX-3](https://jira.example.com/browse/EX-3) Typo in \<title\>
+
+## Story
+
+### API
+
+- [EX-2](https://jira.example.com/browse/EX-2) Export as CSV
+
+### UI
+
+- [EX-2](https://jira.example.com/browse/EX-2) Export as CSV
+`
+	if got := buf.String(); got != want {
+		t.Errorf("Unexpected Markdown:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestGenerator_Render_HTML(t *testing.T) {
+	g := &Generator{Client: newTestClient()}
+
+	var buf bytes.Buffer
+	if err := g.Render(context.Background(), &buf, "10000", HTML); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	got := buf.String()
+	for _, want := range []string{
+		"<h1>1.0 (2026-10-01)</h1>",
+		"<h2>Task</h2>",
+		`<li><a href="https://jira.example.com/browse/EX-3">EX-3</a> Typo in &lt;title&gt;</li>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Expected HTML to contain %q, got:\n%s", want, got)
+		}
+	}
+}
+
+func TestGenerator_Render_JSON(t *testing.T) {
+	g := &Generator{Client: newTestClient()}
+
+	var buf bytes.Buffer
+	if err := g.Render(context.Background(), &buf, "10000", JSON); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	var notes Notes
+	if err := json.Unmarshal(buf.Bytes(), &notes); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if notes.Version.ID != "10000" || len(notes.Issues) != 4 || len(notes.Types) != 3 {
+		t.Errorf("Unexpected notes: %+v", notes)
+	}
+}
+
+func TestGenerator_Render_CustomTemplate(t *testing.T) {
+	g := &Generator{Client: newTestClient()}
+	tmpl := HTMLTemplate(htmltemplate.Must(htmltemplate.New("keys").Parse(`{{ range .Issues }}{{ .Key }} {{ end }}`)))
+
+	var buf bytes.Buffer
+	if err := g.Render(context.Background(), &buf, "10000", tmpl); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got, want := buf.String(), "EX-1 EX-2 EX-3 EX-4 "; got != want {
+		t.Errorf("Rendered %q, want %q", got, want)
+	}
+}
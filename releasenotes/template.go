@@ -0,0 +1,105 @@
+package releasenotes
+
+import (
+	"encoding/json"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	texttemplate "text/template"
+)
+
+// Template renders release notes.
+type Template interface {
+	Render(w io.Writer, notes *Notes) error
+}
+
+// TemplateFunc is an adapter to use an ordinary function as Template.
+type TemplateFunc func(w io.Writer, notes *Notes) error
+
+// Render calls f(w, notes).
+func (f TemplateFunc) Render(w io.Writer, notes *Notes) error {
+	return f(w, notes)
+}
+
+// TextTemplate returns a Template executing t with the *Notes as data.
+// It is meant for plain text formats like Markdown, use HTMLTemplate for HTML.
+func TextTemplate(t *texttemplate.Template) Template {
+	return TemplateFunc(func(w io.Writer, notes *Notes) error {
+		return t.Execute(w, notes)
+	})
+}
+
+// HTMLTemplate returns a Template executing t with the *Notes as data.
+func HTMLTemplate(t *htmltemplate.Template) Template {
+	return TemplateFunc(func(w io.Writer, notes *Notes) error {
+		return t.Execute(w, notes)
+	})
+}
+
+// Funcs are the functions available in the built-in templates.
+// Custom templates can use them via Funcs(releasenotes.Funcs):
+//
+//	markdown: escapes the Markdown control characters of a string
+var Funcs = map[string]interface{}{
+	"markdown": escapeMarkdown,
+}
+
+// The built-in templates.
+var (
+	// Markdown renders the release notes as Markdown, with a heading per issue type and component.
+	Markdown = TextTemplate(texttemplate.Must(texttemplate.New("markdown").Funcs(Funcs).Parse(markdownTemplate)))
+
+	// HTML renders the release notes as HTML fragment, with a heading per issue type and component.
+	HTML = HTMLTemplate(htmltemplate.Must(htmltemplate.New("html").Funcs(Funcs).Parse(htmlTemplate)))
+
+	// JSON renders the release notes as indented JSON.
+	JSON Template = TemplateFunc(renderJSON)
+)
+
+const markdownTemplate = `# {{ markdown .Version.Name }}{{ with .Version.ReleaseDate }} ({{ . }}){{ end }}
+{{ with .Version.Description }}
+{{ markdown . }}
+{{ end }}
+{{- range .Types }}
+## {{ markdown .Type }}
+{{ range .Components }}
+### {{ markdown .Component }}
+
+{{ range .Issues }}- {{ if .URL }}[{{ .Key }}]({{ .URL }}){{ else }}{{ .Key }}{{ end }} {{ markdown .Summary }}
+{{ end }}
+{{- end }}
+{{- end }}`
+
+const htmlTemplate = `<h1>{{ .Version.Name }}{{ with .Version.ReleaseDate }} ({{ . }}){{ end }}</h1>
+{{ with .Version.Description }}<p>{{ . }}</p>
+{{ end }}
+{{- range .Types }}<h2>{{ .Type }}</h2>
+{{ range .Components }}<h3>{{ .Component }}</h3>
+<ul>
+{{ range .Issues }}<li>{{ if .URL }}<a href="{{ .URL }}">{{ .Key }}</a>{{ else }}{{ .Key }}{{ end }} {{ .Summary }}</li>
+{{ end }}</ul>
+{{ end }}
+{{- end }}`
+
+func renderJSON(w io.Writer, notes *Notes) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(notes)
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	`*`, `\*`,
+	`_`, `\_`,
+	`[`, `\[`,
+	`]`, `\]`,
+	`<`, `\<`,
+	`>`, `\>`,
+	`#`, `\#`,
+)
+
+// escapeMarkdown escapes the Markdown control characters of s.
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
@@ -0,0 +1,58 @@
+package timesheet
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// csvHeader are the columns of WriteCSV.
+var csvHeader = []string{"bucket", "started", "user", "user_name", "project", "issue", "summary", "comment", "hours", "seconds"}
+
+// WriteCSV writes the entries of t as CSV with a header row, one row per worklog.
+// The bucket column is the date of the day or week of the worklog, so the rows can be grouped in a spreadsheet.
+// Text starting with a formula character (=, +, -, @, tab or carriage return) is prefixed with a single quote,
+// so that spreadsheets don't evaluate user provided summaries and comments as formulas.
+func (t *Timesheet) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, e := range t.Entries {
+		record := []string{
+			e.Bucket.Format(dateLayout),
+			e.Started.Format(time.RFC3339),
+			csvText(e.User),
+			csvText(e.UserName),
+			csvText(e.Project),
+			csvText(e.Issue),
+			csvText(e.Summary),
+			csvText(e.Comment),
+			strconv.FormatFloat(float64(e.TimeSpentSeconds)/3600, 'f', 2, 64),
+			strconv.Itoa(e.TimeSpentSeconds),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// csvText neutralizes s if a spreadsheet would interpret it as a formula (CSV injection).
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// WriteJSON writes t as indented JSON.
+func (t *Timesheet) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(t)
+}
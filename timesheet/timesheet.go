@@ -0,0 +1,330 @@
+// Package timesheet aggregates the time logged on issues into timesheets:
+// totals per user, project and issue for a period of time, bucketed by day or week.
+//
+// The issues are found with JQL (worklogDate, worklogAuthor) and their worklogs are fetched
+// via the jira.Client, so it works with Jira Cloud and Jira Server / Data Center:
+//
+//	g := &timesheet.Generator{
+//		Client:   client,
+//		Location: berlin, // the time zone of the days and weeks
+//		Period:   timesheet.Week,
+//		Projects: []string{"EX"},
+//	}
+//	ts, err := g.Generate(ctx, from, to)
+//	err = ts.WriteCSV(os.Stdout)
+package timesheet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2"
+)
+
+// Period is the length of the buckets of a Timesheet.
+type Period string
+
+const (
+	// Day buckets the worklogs per calendar day.
+	Day Period = "day"
+	// Week buckets the worklogs per week, starting at Generator.WeekStart.
+	Week Period = "week"
+)
+
+// dateLayout is the layout of JQL dates.
+const dateLayout = "2006-01-02"
+
+// Generator generates timesheets.
+type Generator struct {
+	Client jira.Client
+
+	// Location is the time zone the worklogs are bucketed in. Default: UTC.
+	Location *time.Location
+
+	// Period of the buckets. Default: Day.
+	Period Period
+
+	// WeekStart is the first day of the week buckets. Default: Monday.
+	WeekStart *time.Weekday
+
+	// Users are the IDs (see jira.User.ID) of the users to include. All users if empty.
+	Users []string
+
+	// Projects are the keys of the projects to include. All projects if empty.
+	Projects []string
+
+	// JQL is an additional condition for the issues, e.g. "labels = billable".
+	JQL string
+}
+
+// Timesheet is the time logged in a period of time.
+type Timesheet struct {
+	// From and To are the period of time, To is exclusive.
+	From   time.Time `json:"from"`
+	To     time.Time `json:"to"`
+	Period Period    `json:"period"`
+
+	TimeSpentSeconds int `json:"timeSpentSeconds"`
+
+	// Users, Projects and Issues are the totals of the whole period of time.
+	Users    []Total `json:"users"`
+	Projects []Total `json:"projects"`
+	Issues   []Total `json:"issues"`
+
+	// Buckets are the totals per day or week, ordered by time. Buckets without worklogs are left out.
+	Buckets []Bucket `json:"buckets"`
+
+	// Entries are all worklogs of the timesheet, ordered by their start.
+	Entries []Entry `json:"entries"`
+}
+
+// Bucket is the time logged in a day or week.
+type Bucket struct {
+	// Start is the beginning of the day or week in the Location of the Generator.
+	Start time.Time `json:"start"`
+
+	TimeSpentSeconds int `json:"timeSpentSeconds"`
+
+	Users    []Total `json:"users"`
+	Projects []Total `json:"projects"`
+	Issues   []Total `json:"issues"`
+}
+
+// Total is the time logged by a user, on a project or on an issue.
+type Total struct {
+	// Key is the user ID, the project key or the issue key.
+	Key string `json:"key"`
+	// Name is the display name of the user, the name of the project or the summary of the issue.
+	Name string `json:"name"`
+
+	TimeSpentSeconds int `json:"timeSpentSeconds"`
+}
+
+// Entry is a worklog of a Timesheet.
+type Entry struct {
+	WorklogID string `json:"worklogId"`
+	Issue     string `json:"issue"`
+	Summary   string `json:"summary"`
+	Project   string `json:"project"`
+	// ProjectName is the name of the project.
+	ProjectName string `json:"projectName"`
+	User        string `json:"user"`
+	UserName    string `json:"userName"`
+	Comment     string `json:"comment,omitempty"`
+
+	// Started is the start of the worklog in the Location of the Generator.
+	Started time.Time `json:"started"`
+	// Bucket is the start of the day or week of the worklog.
+	Bucket time.Time `json:"bucket"`
+
+	TimeSpentSeconds int `json:"timeSpentSeconds"`
+}
+
+// TimeSpent returns the time logged of t.
+func (t *Timesheet) TimeSpent() time.Duration { return seconds(t.TimeSpentSeconds) }
+
+// TimeSpent returns the time logged of b.
+func (b *Bucket) TimeSpent() time.Duration { return seconds(b.TimeSpentSeconds) }
+
+// TimeSpent returns the time logged of t.
+func (t *Total) TimeSpent() time.Duration { return seconds(t.TimeSpentSeconds) }
+
+// TimeSpent returns the time logged of e.
+func (e *Entry) TimeSpent() time.Duration { return seconds(e.TimeSpentSeconds) }
+
+func seconds(s int) time.Duration {
+	return time.Duration(s) * time.Second
+}
+
+// Generate returns the timesheet of the worklogs started in [from, to).
+func (g *Generator) Generate(ctx context.Context, from, to time.Time) (*Timesheet, error) {
+	if g.Client == nil {
+		return nil, errors.New("timesheet: no client configured")
+	}
+	if !from.Before(to) {
+		return nil, fmt.Errorf("timesheet: from %s is not before to %s", from, to)
+	}
+
+	loc := g.location()
+	ts := &Timesheet{From: from.In(loc), To: to.In(loc), Period: g.period()}
+	users := make(map[string]bool, len(g.Users))
+	for _, u := range g.Users {
+		users[u] = true
+	}
+
+	options := &jira.SearchOptions{Fields: []string{"summary", "project"}}
+	err := g.Client.Search().SearchPages(ctx, g.jql(from, to), options, func(issue jira.Issue) error {
+		worklogs, err := g.Client.Worklog().ListByIssue(ctx, issue.Key)
+		if err != nil {
+			return fmt.Errorf("getting worklogs of %s: %w", issue.Key, err)
+		}
+		for _, w := range worklogs {
+			if w.Started.Before(from) || !w.Started.Before(to) {
+				continue
+			}
+			entry := Entry{
+				WorklogID:        w.ID,
+				Issue:            issue.Key,
+				Summary:          issue.Summary,
+				Project:          issue.Project.Key,
+				ProjectName:      issue.Project.Name,
+				Comment:          w.Comment,
+				Started:          w.Started.In(loc),
+				TimeSpentSeconds: int(w.TimeSpent / time.Second),
+			}
+			if w.Author != nil {
+				entry.User = w.Author.ID
+				entry.UserName = w.Author.DisplayName
+			}
+			if len(users) > 0 && !users[entry.User] {
+				continue
+			}
+			entry.Bucket = g.bucket(entry.Started)
+			ts.Entries = append(ts.Entries, entry)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("timesheet: %w", err)
+	}
+
+	ts.aggregate()
+	return ts, nil
+}
+
+// jql returns the JQL of the issues with worklogs in [from, to).
+// JQL compares worklogDate in the time zone of the user the client is authenticated as,
+// so the dates are extended by a day, and the worklogs are filtered exactly by Generate.
+func (g *Generator) jql(from, to time.Time) string {
+	loc := g.location()
+	conditions := []string{
+		fmt.Sprintf(`worklogDate >= "%s"`, from.In(loc).AddDate(0, 0, -1).Format(dateLayout)),
+		fmt.Sprintf(`worklogDate <= "%s"`, to.In(loc).AddDate(0, 0, 1).Format(dateLayout)),
+	}
+	if len(g.Users) > 0 {
+		conditions = append(conditions, "worklogAuthor in ("+quote(g.Users)+")")
+	}
+	if len(g.Projects) > 0 {
+		conditions = append(conditions, "project in ("+quote(g.Projects)+")")
+	}
+	if g.JQL != "" {
+		conditions = append(conditions, "("+g.JQL+")")
+	}
+	return strings.Join(conditions, " AND ") + " ORDER BY key ASC"
+}
+
+func quote(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, strconv.Quote(v))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func (g *Generator) location() *time.Location {
+	if g.Location == nil {
+		return time.UTC
+	}
+	return g.Location
+}
+
+func (g *Generator) period() Period {
+	if g.Period == "" {
+		return Day
+	}
+	return g.Period
+}
+
+// bucket returns the start of the day or week of t, which is in the Location of g.
+func (g *Generator) bucket(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	if g.period() != Week {
+		return day
+	}
+	weekStart := time.Monday
+	if g.WeekStart != nil {
+		weekStart = *g.WeekStart
+	}
+	offset := (int(day.Weekday()) - int(weekStart) + 7) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// aggregate sorts the entries and computes the totals and buckets.
+func (ts *Timesheet) aggregate() {
+	sort.SliceStable(ts.Entries, func(i, j int) bool {
+		if !ts.Entries[i].Started.Equal(ts.Entries[j].Started) {
+			return ts.Entries[i].Started.Before(ts.Entries[j].Started)
+		}
+		return ts.Entries[i].WorklogID < ts.Entries[j].WorklogID
+	})
+
+	all := newTotals()
+	buckets := make(map[time.Time]*totals)
+	var starts []time.Time
+	for _, e := range ts.Entries {
+		all.add(e)
+		b, ok := buckets[e.Bucket]
+		if !ok {
+			b = newTotals()
+			buckets[e.Bucket] = b
+			starts = append(starts, e.Bucket)
+		}
+		b.add(e)
+	}
+
+	ts.TimeSpentSeconds = all.seconds
+	ts.Users, ts.Projects, ts.Issues = all.users.list(), all.projects.list(), all.issues.list()
+	sort.Slice(starts, func(i, j int) bool { return starts[i].Before(starts[j]) })
+	for _, start := range starts {
+		b := buckets[start]
+		ts.Buckets = append(ts.Buckets, Bucket{
+			Start:            start,
+			TimeSpentSeconds: b.seconds,
+			Users:            b.users.list(),
+			Projects:         b.projects.list(),
+			Issues:           b.issues.list(),
+		})
+	}
+}
+
+type totals struct {
+	seconds                 int
+	users, projects, issues totalMap
+}
+
+func newTotals() *totals {
+	return &totals{users: totalMap{}, projects: totalMap{}, issues: totalMap{}}
+}
+
+func (t *totals) add(e Entry) {
+	t.seconds += e.TimeSpentSeconds
+	t.users.add(e.User, e.UserName, e.TimeSpentSeconds)
+	t.projects.add(e.Project, e.ProjectName, e.TimeSpentSeconds)
+	t.issues.add(e.Issue, e.Summary, e.TimeSpentSeconds)
+}
+
+type totalMap map[string]*Total
+
+func (m totalMap) add(key, name string, seconds int) {
+	t, ok := m[key]
+	if !ok {
+		t = &Total{Key: key, Name: name}
+		m[key] = t
+	}
+	t.TimeSpentSeconds += seconds
+}
+
+// list returns the totals ordered by key.
+func (m totalMap) list() []Total {
+	list := make([]Total, 0, len(m))
+	for _, t := range m {
+		list = append(list, *t)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Key < list[j].Key })
+	return list
+}
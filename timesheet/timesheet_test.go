@@ -0,0 +1,207 @@
+package timesheet
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2"
+)
+
+// testClient is a jira.Client serving search results by JQL and worklogs by issue key.
+type testClient struct {
+	jira.Client
+
+	issues   map[string][]jira.Issue
+	worklogs map[string][]jira.Worklog
+	jql      []string
+}
+
+func (c *testClient) Search() jira.SearchAPI   { return (*testSearchAPI)(c) }
+func (c *testClient) Worklog() jira.WorklogAPI { return (*testWorklogAPI)(c) }
+
+type testSearchAPI testClient
+
+func (a *testSearchAPI) Search(ctx context.Context, jql string, options *jira.SearchOptions) (*jira.SearchResult, error) {
+	a.jql = append(a.jql, jql)
+	return &jira.SearchResult{Issues: a.issues[jql]}, nil
+}
+
+func (a *testSearchAPI) SearchPages(ctx context.Context, jql string, options *jira.SearchOptions, f func(jira.Issue) error) error {
+	result, _ := a.Search(ctx, jql, options)
+	for _, i := range result.Issues {
+		if err := f(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type testWorklogAPI testClient
+
+func (a *testWorklogAPI) ListByIssue(ctx context.Context, issueIDOrKey string) ([]jira.Worklog, error) {
+	worklogs, ok := a.worklogs[issueIDOrKey]
+	if !ok {
+		return nil, errors.New("issue not found")
+	}
+	return worklogs, nil
+}
+
+var (
+	alice = &jira.User{ID: "alice", DisplayName: "Alice"}
+	bob   = &jira.User{ID: "bob", DisplayName: "Bob"}
+)
+
+func newTestClient(jql string) *testClient {
+	ex := jira.Project{Key: "EX", Name: "Example"}
+	ops := jira.Project{Key: "OPS", Name: "Operations"}
+	return &testClient{
+		issues: map[string][]jira.Issue{
+			jql: {
+				{Key: "EX-1", Summary: "Export as CSV", Project: ex},
+				{Key: "OPS-7", Summary: "Upgrade database", Project: ops},
+			},
+		},
+		worklogs: map[string][]jira.Worklog{
+			"EX-1": {
+				// Friday 23:30 UTC is Saturday 01:30 in Berlin
+				{ID: "1", Author: alice, Started: time.Date(2026, 10, 2, 23, 30, 0, 0, time.UTC), TimeSpent: 2 * time.Hour},
+				{ID: "2", Author: bob, Started: time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC), TimeSpent: 90 * time.Minute},
+				// Outside of the period
+				{ID: "3", Author: alice, Started: time.Date(2026, 9, 30, 9, 0, 0, 0, time.UTC), TimeSpent: time.Hour},
+			},
+			"OPS-7": {
+				{ID: "4", Author: alice, Started: time.Date(2026, 10, 5, 12, 0, 0, 0, time.UTC), TimeSpent: 30 * time.Minute, Comment: "Backup"},
+			},
+		},
+	}
+}
+
+func TestGenerator_Generate(t *testing.T) {
+	berlin := time.FixedZone("CEST", 2*60*60)
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, berlin)
+	to := time.Date(2026, 10, 8, 0, 0, 0, 0, berlin)
+	c := newTestClient(`worklogDate >= "2026-09-30" AND worklogDate <= "2026-10-09" AND project in ("EX", "OPS") ORDER BY key ASC`)
+	g := &Generator{Client: c, Location: berlin, Projects: []string{"EX", "OPS"}}
+
+	ts, err := g.Generate(context.Background(), from, to)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(c.jql) != 1 || len(ts.Entries) != 3 {
+		t.Fatalf("Expected 3 entries, got %+v (JQL %q)", ts.Entries, c.jql)
+	}
+	if ts.TimeSpent() != 4*time.Hour {
+		t.Errorf("Expected 4h, got %s", ts.TimeSpent())
+	}
+
+	wantUsers := []Total{{Key: "alice", Name: "Alice", TimeSpentSeconds: 9000}, {Key: "bob", Name: "Bob", TimeSpentSeconds: 5400}}
+	for i, u := range wantUsers {
+		if i >= len(ts.Users) || ts.Users[i] != u {
+			t.Errorf("Expected users %+v, got %+v", wantUsers, ts.Users)
+			break
+		}
+	}
+	if len(ts.Projects) != 2 || ts.Projects[1] != (Total{Key: "OPS", Name: "Operations", TimeSpentSeconds: 1800}) {
+		t.Errorf("Unexpected projects %+v", ts.Projects)
+	}
+	if len(ts.Issues) != 2 || ts.Issues[0] != (Total{Key: "EX-1", Name: "Export as CSV", TimeSpentSeconds: 12600}) {
+		t.Errorf("Unexpected issues %+v", ts.Issues)
+	}
+
+	if len(ts.Buckets) != 2 {
+		t.Fatalf("Expected 2 day buckets, got %+v", ts.Buckets)
+	}
+	if want := time.Date(2026, 10, 3, 0, 0, 0, 0, berlin); !ts.Buckets[0].Start.Equal(want) {
+		t.Errorf("Expected the first worklog on %s in Berlin, got %s", want, ts.Buckets[0].Start)
+	}
+	if b := ts.Buckets[1]; b.TimeSpentSeconds != 7200 || len(b.Users) != 2 || len(b.Issues) != 2 {
+		t.Errorf("Unexpected bucket %+v", b)
+	}
+}
+
+func TestGenerator_Generate_Week(t *testing.T) {
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 8, 0, 0, 0, 0, time.UTC)
+	c := newTestClient(`worklogDate >= "2026-09-30" AND worklogDate <= "2026-10-09" AND worklogAuthor in ("alice") AND (labels = billable) ORDER BY key ASC`)
+	sunday := time.Sunday
+	g := &Generator{Client: c, Period: Week, WeekStart: &sunday, Users: []string{"alice"}, JQL: "labels = billable"}
+
+	ts, err := g.Generate(context.Background(), from, to)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(ts.Entries) != 2 || len(ts.Users) != 1 {
+		t.Fatalf("Expected the 2 worklogs of alice, got %+v (JQL %q)", ts.Entries, c.jql)
+	}
+
+	want := []time.Time{time.Date(2026, 9, 27, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 4, 0, 0, 0, 0, time.UTC)}
+	if len(ts.Buckets) != len(want) {
+		t.Fatalf("Expected %d week buckets, got %+v", len(want), ts.Buckets)
+	}
+	for i, start := range want {
+		if !ts.Buckets[i].Start.Equal(start) {
+			t.Errorf("Expected week %d to start on %s, got %s", i, start, ts.Buckets[i].Start)
+		}
+	}
+}
+
+func TestGenerator_Generate_Error(t *testing.T) {
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	c := newTestClient(`worklogDate >= "2026-09-30" AND worklogDate <= "2026-10-03" ORDER BY key ASC`)
+	delete(c.worklogs, "OPS-7")
+	g := &Generator{Client: c}
+
+	if _, err := g.Generate(context.Background(), from, from.AddDate(0, 0, 1)); err == nil {
+		t.Error("Expected an error of the worklogs")
+	}
+	if _, err := g.Generate(context.Background(), from, from); err == nil {
+		t.Error("Expected an error of the empty period")
+	}
+}
+
+func TestTimesheet_Write(t *testing.T) {
+	started := time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC)
+	ts := &Timesheet{Period: Day, TimeSpentSeconds: 5400, Entries: []Entry{
+		{WorklogID: "2", Issue: "EX-1", Summary: "Export, as CSV", Project: "EX", User: "bob", UserName: "Bob", Started: started, Bucket: started.Truncate(24 * time.Hour), TimeSpentSeconds: 5400},
+		{WorklogID: "3", Issue: "EX-2", Summary: "=HYPERLINK(\"http://evil.example.com\")", Comment: "-1+1", UserName: "@bob", Started: started, Bucket: started, TimeSpentSeconds: 60},
+	}}
+
+	var buf bytes.Buffer
+	if err := ts.WriteCSV(&buf); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("Error reading CSV: %s", err)
+	}
+	want := []string{"2026-10-05", "2026-10-05T09:00:00Z", "bob", "Bob", "EX", "EX-1", "Export, as CSV", "", "1.50", "5400"}
+	if len(records) != 3 || len(records[1]) != len(want) {
+		t.Fatalf("Unexpected CSV %q", records)
+	}
+	for i := range want {
+		if records[1][i] != want[i] {
+			t.Errorf("Expected column %s to be %q, got %q", records[0][i], want[i], records[1][i])
+		}
+	}
+
+	if got := records[2]; got[3] != "'@bob" || got[6] != `'=HYPERLINK("http://evil.example.com")` || got[7] != "'-1+1" {
+		t.Errorf("Expected formulas to be neutralized, got %q", got)
+	}
+
+	buf.Reset()
+	if err := ts.WriteJSON(&buf); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	var decoded Timesheet
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("Error decoding JSON: %s", err)
+	}
+	if decoded.TimeSpentSeconds != 5400 || len(decoded.Entries) != 2 || decoded.Entries[0].Issue != "EX-1" {
+		t.Errorf("Unexpected JSON %s", buf.String())
+	}
+}